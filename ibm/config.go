@@ -2,9 +2,7 @@ package ibm
 
 import (
 	"errors"
-	"fmt"
 	"log"
-	"os"
 	"time"
 
 	slsession "github.com/softlayer/softlayer-go/session"
@@ -15,15 +13,13 @@ import (
 	"github.com/IBM-Bluemix/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Bluemix/bluemix-go/api/iampap/iampapv1"
 	"github.com/IBM-Bluemix/bluemix-go/api/mccp/mccpv2"
+	bxhttp "github.com/IBM-Bluemix/bluemix-go/http"
 	bxsession "github.com/IBM-Bluemix/bluemix-go/session"
 )
 
 //SoftlayerRestEndpoint rest endpoint of SoftLayer
 const SoftlayerRestEndpoint = "https://api.softlayer.com/rest/v3"
 
-//BluemixRegion ...
-var BluemixRegion string
-
 var (
 	errEmptySoftLayerCredentials = errors.New("softlayer_username and softlayer_api_key must be provided. Please see the documentation on how to configure them")
 	errEmptyBluemixCredentials   = errors.New("bluemix_api_key must be provided. Please see the documentation on how to configure it")
@@ -50,6 +46,24 @@ type Config struct {
 	// Softlayer API Key
 	SoftLayerAPIKey string
 
+	//Maximum number of SoftLayer orders placed concurrently, 0 means unlimited
+	MaxConcurrentOrders int
+
+	//Only price the SoftLayer orders instead of placing them
+	DryRunQuote bool
+
+	//Skip the attributes which are expensive to read when refreshing existing resources
+	SkipDetailedRefresh bool
+
+	//Check the hourly instance and server limits of the account when the resources are planned
+	CheckAccountLimits bool
+
+	//Log the HTTP requests and responses of the API calls, with the credentials redacted
+	Debug bool
+
+	//Correlation ID added to the User-Agent of the API calls to identify the Terraform run
+	CorrelationID string
+
 	//Retry Count for API calls
 	//Unexposed in the schema at this point as they are used only during session creation for a few calls
 	//When sdk implements it we an expose them for expected behaviour
@@ -71,8 +85,15 @@ type Session struct {
 // ClientSession ...
 type ClientSession interface {
 	SoftLayerSession() *slsession.Session
+	OrderSerializer() *orderSerializer
+	DryRunQuote() bool
+	SkipDetailedRefresh() bool
+	AccountLimits() *accountLimits
+	RequestTagger() *requestTagger
 	BluemixSession() (*bxsession.Session, error)
+	BluemixRegion() string
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
+	ContainerRegionAPI(region string) (containerv1.ContainerServiceAPI, error)
 	IAMAPI() (iampapv1.IAMPAPAPI, error)
 	MccpAPI() (mccpv2.MccpServiceAPI, error)
 	BluemixAcccountAPI() (accountv2.AccountServiceAPI, error)
@@ -82,20 +103,13 @@ type ClientSession interface {
 type clientSession struct {
 	session *Session
 
-	csConfigErr  error
-	csServiceAPI containerv1.ContainerServiceAPI
-
-	cfConfigErr  error
-	cfServiceAPI mccpv2.MccpServiceAPI
+	orderSerializer     *orderSerializer
+	dryRunQuote         bool
+	skipDetailedRefresh bool
+	accountLimits       *accountLimits
+	requestTagger       *requestTagger
 
-	iamConfigErr  error
-	iamServiceAPI iampapv1.IAMPAPAPI
-
-	accountConfigErr     error
-	bmxAccountServiceAPI accountv2.AccountServiceAPI
-
-	accountV1ConfigErr     error
-	bmxAccountv1ServiceAPI accountv1.AccountServiceAPI
+	bluemixClients *bluemixClients
 }
 
 // SoftLayerSession providers SoftLayer Session
@@ -103,90 +117,131 @@ func (sess clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
+// OrderSerializer provides the serializer of the SoftLayer orders
+func (sess clientSession) OrderSerializer() *orderSerializer {
+	return sess.orderSerializer
+}
+
+// DryRunQuote tells whether the SoftLayer orders must only be priced
+func (sess clientSession) DryRunQuote() bool {
+	return sess.dryRunQuote
+}
+
+// SkipDetailedRefresh tells whether the expensive attributes must be skipped when refreshing
+func (sess clientSession) SkipDetailedRefresh() bool {
+	return sess.skipDetailedRefresh
+}
+
+// AccountLimits provides the limits of the account checked when planning, nil when they are not checked
+func (sess clientSession) AccountLimits() *accountLimits {
+	return sess.accountLimits
+}
+
+// RequestTagger provides the tagger identifying the Terraform run in the API calls
+func (sess clientSession) RequestTagger() *requestTagger {
+	return sess.requestTagger
+}
+
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
 func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
-	return sess.cfServiceAPI, sess.cfConfigErr
+	api, err := sess.bluemixClients.client(mccpService)
+	if err != nil {
+		return nil, err
+	}
+	return api.(mccpv2.MccpServiceAPI), nil
 }
 
 // BluemixAcccountAPI ...
 func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
-	return sess.bmxAccountServiceAPI, sess.accountConfigErr
+	api, err := sess.bluemixClients.client(accountService)
+	if err != nil {
+		return nil, err
+	}
+	return api.(accountv2.AccountServiceAPI), nil
 }
 
 // BluemixAcccountAPI ...
 func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
-	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
+	api, err := sess.bluemixClients.client(accountV1Service)
+	if err != nil {
+		return nil, err
+	}
+	return api.(accountv1.AccountServiceAPI), nil
 }
 
 // IAMAPI provides IAM PAP APIs ...
 func (sess clientSession) IAMAPI() (iampapv1.IAMPAPAPI, error) {
-	return sess.iamServiceAPI, sess.iamConfigErr
+	api, err := sess.bluemixClients.client(iamPAPService)
+	if err != nil {
+		return nil, err
+	}
+	return api.(iampapv1.IAMPAPAPI), nil
 }
 
 // ContainerAPI provides Container Service APIs ...
 func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
-	return sess.csServiceAPI, sess.csConfigErr
+	api, err := sess.bluemixClients.client(containerService)
+	if err != nil {
+		return nil, err
+	}
+	return api.(containerv1.ContainerServiceAPI), nil
+}
+
+// ContainerRegionAPI provides Container Service APIs in the region, or in the region of the
+// provider when region is empty
+func (sess clientSession) ContainerRegionAPI(region string) (containerv1.ContainerServiceAPI, error) {
+	api, err := sess.bluemixClients.regionalClient(containerService, region)
+	if err != nil {
+		return nil, err
+	}
+	return api.(containerv1.ContainerServiceAPI), nil
 }
 
 // BluemixSession to provide the Bluemix Session
 func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
-	return sess.session.BluemixSession, sess.cfConfigErr
+	if sess.session.BluemixSession == nil {
+		return nil, errEmptyBluemixCredentials
+	}
+	return sess.session.BluemixSession, nil
+}
+
+// BluemixRegion provides the Bluemix region of the provider, empty when the Bluemix credentials
+// are not set
+func (sess clientSession) BluemixRegion() string {
+	if sess.session.BluemixSession == nil || sess.session.BluemixSession.Config == nil {
+		return ""
+	}
+	return sess.session.BluemixSession.Config.Region
 }
 
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
-	sess, err := newSession(c)
+	tagger := newRequestTagger(c.CorrelationID)
+	sess, err := newSession(c, tagger)
 	if err != nil {
 		return nil, err
 	}
 	session := clientSession{
-		session: sess,
+		session:             sess,
+		orderSerializer:     newOrderSerializer(c.MaxConcurrentOrders),
+		dryRunQuote:         c.DryRunQuote,
+		skipDetailedRefresh: c.SkipDetailedRefresh,
+		requestTagger:       tagger,
+		bluemixClients:      newBluemixClients(sess.BluemixSession, bluemixClientFactories),
+	}
+	if c.CheckAccountLimits {
+		session.accountLimits = newAccountLimits(sess.SoftLayerSession)
 	}
 	if sess.BluemixSession == nil {
-		//Can be nil only  if bluemix_api_key is not provided
 		log.Println("Skipping Bluemix Clients configuration")
-		session.csConfigErr = errEmptyBluemixCredentials
-		session.cfConfigErr = errEmptyBluemixCredentials
-		session.accountConfigErr = errEmptyBluemixCredentials
-		session.accountV1ConfigErr = errEmptyBluemixCredentials
-		session.iamConfigErr = errEmptyBluemixCredentials
-		return session, nil
-	}
-
-	BluemixRegion = sess.BluemixSession.Config.Region
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
-	if err != nil {
-		session.cfConfigErr = fmt.Errorf("Error occured while configuring MCCP service: %q", err)
-	}
-	session.cfServiceAPI = cfAPI
-
-	accAPI, err := accountv2.New(sess.BluemixSession)
-	if err != nil {
-		session.accountConfigErr = fmt.Errorf("Error occured while configuring  Account Service: %q", err)
-	}
-	session.bmxAccountServiceAPI = accAPI
-
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
-	if err != nil {
-		session.csConfigErr = fmt.Errorf("Error occured while configuring Container Service for K8s cluster: %q", err)
 	}
-	session.csServiceAPI = clusterAPI
 
-	accv1API, err := accountv1.New(sess.BluemixSession)
-	if err != nil {
-		session.accountV1ConfigErr = fmt.Errorf("Error occured while configuring Bluemix Accountv1 Service: %q", err)
-	}
-	session.bmxAccountv1ServiceAPI = accv1API
-
-	iampap, err := iampapv1.New(sess.BluemixSession)
-	if err != nil {
-		session.iamConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAMPAP Service: %q", err)
-	}
-	session.iamServiceAPI = iampap
+	// The clients of the Bluemix services are configured when they are first used. The region is
+	// read from the session of each provider, so that aliased providers can target other regions.
 	return session, nil
 }
 
-func newSession(c *Config) (*Session, error) {
+func newSession(c *Config, tagger *requestTagger) (*Session, error) {
 	ibmSession := &Session{}
 
 	log.Println("Configuring SoftLayer Session ")
@@ -195,7 +250,8 @@ func newSession(c *Config) (*Session, error) {
 		Timeout:  c.SoftLayerTimeout,
 		UserName: c.SoftLayerUserName,
 		APIKey:   c.SoftLayerAPIKey,
-		Debug:    os.Getenv("TF_LOG") != "",
+
+		TransportHandler: newSoftLayerTransport(c.SoftLayerEndpointURL, c.Debug, tagger),
 	}
 	ibmSession.SoftLayerSession = softlayerSession
 
@@ -204,7 +260,6 @@ func newSession(c *Config) (*Session, error) {
 		var sess *bxsession.Session
 		bmxConfig := &bluemix.Config{
 			BluemixAPIKey: c.BluemixAPIKey,
-			Debug:         os.Getenv("TF_LOG") != "",
 			HTTPTimeout:   c.BluemixTimeout,
 			Region:        c.Region,
 			RetryDelay:    &c.RetryDelay,
@@ -214,6 +269,12 @@ func newSession(c *Config) (*Session, error) {
 		if err != nil {
 			return nil, err
 		}
+		httpClient := bxhttp.NewHTTPClient(sess.Config)
+		httpClient.Transport = newUserAgentTransport(httpClient.Transport, tagger)
+		if c.Debug {
+			httpClient.Transport = newDebugTransport(httpClient.Transport)
+		}
+		sess.Config.HTTPClient = httpClient
 		ibmSession.BluemixSession = sess
 	}
 
@@ -0,0 +1,65 @@
+package ibm
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClientSessionDualAccount(t *testing.T) {
+	configs := []Config{
+		{
+			BluemixAPIKey:        "bluemix-key-1",
+			Region:               "us-south",
+			SoftLayerUserName:    "user-1",
+			SoftLayerAPIKey:      "softlayer-key-1",
+			SoftLayerEndpointURL: SoftlayerRestEndpoint,
+			RetryCount:           3,
+			RetryDelay:           30 * time.Millisecond,
+		},
+		{
+			BluemixAPIKey:        "bluemix-key-2",
+			Region:               "eu-gb",
+			SoftLayerUserName:    "user-2",
+			SoftLayerAPIKey:      "softlayer-key-2",
+			SoftLayerEndpointURL: SoftlayerRestEndpoint,
+			RetryCount:           3,
+			RetryDelay:           30 * time.Millisecond,
+		},
+	}
+
+	sessions := make([]ClientSession, len(configs))
+	for i := range configs {
+		sess, err := configs[i].ClientSession()
+		if err != nil {
+			t.Fatalf("Unexpected error configuring the session of %s: %s", configs[i].SoftLayerUserName, err)
+		}
+		sessions[i] = sess.(ClientSession)
+	}
+
+	// The first session must not be changed by the configuration of the second one
+	for i, sess := range sessions {
+		if region := sess.BluemixRegion(); region != configs[i].Region {
+			t.Errorf("Expected the region %s, got %s", configs[i].Region, region)
+		}
+		if user := sess.SoftLayerSession().UserName; user != configs[i].SoftLayerUserName {
+			t.Errorf("Expected the SoftLayer user %s, got %s", configs[i].SoftLayerUserName, user)
+		}
+		bmxSess, err := sess.BluemixSession()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if key := bmxSess.Config.BluemixAPIKey; key != configs[i].BluemixAPIKey {
+			t.Errorf("Expected the Bluemix API key of the account %d, got %s", i, key)
+		}
+	}
+	if sessions[0].OrderSerializer() == sessions[1].OrderSerializer() {
+		t.Errorf("Expected each session to have its own order serializer")
+	}
+}
+
+func TestClientSessionBluemixRegionWithoutBluemixCredentials(t *testing.T) {
+	sess := clientSession{session: &Session{}}
+	if region := sess.BluemixRegion(); region != "" {
+		t.Errorf("Expected no region without the Bluemix credentials, got %s", region)
+	}
+}
@@ -0,0 +1,201 @@
+package ibm
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const accountLimitsVlanMask = "id,vlanNumber,subnetCount,primaryRouter[hostname,datacenter[name]]"
+
+func dataSourceIBMAccountLimits() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMAccountLimitsRead,
+
+		Schema: map[string]*schema.Schema{
+			"datacenter": {
+				Description: "The datacenter of the routers and VLANs, all the datacenters when not set",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"hourly_instance_limit": {
+				Description: "The maximum number of hourly virtual guests of the account",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"hourly_instance_count": {
+				Description: "The number of hourly virtual guests of the account",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"hourly_server_limit": {
+				Description: "The maximum number of hourly bare metal servers of the account",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"hourly_server_count": {
+				Description: "The number of hourly bare metal servers of the account",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"global_ip_count": {
+				Description: "The number of global IP addresses of the account",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"routers": {
+				Description: "The number of VLANs of the account by router",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datacenter": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"vlans": {
+				Description: "The number of subnets of the VLANs of the account",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vlan_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"router_hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMAccountLimitsRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	service := services.GetAccountService(sess)
+
+	instanceLimit, err := service.HourlyInstanceLimit()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the hourly instance limit of the account: %s", err)
+	}
+	serverLimit, err := service.HourlyServerLimit()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the hourly server limit of the account: %s", err)
+	}
+	guests, err := service.Mask("id").GetHourlyVirtualGuests()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the hourly virtual guests of the account: %s", err)
+	}
+	servers, err := service.Mask("id").GetHourlyBareMetalInstances()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the hourly bare metal servers of the account: %s", err)
+	}
+	globalIps, err := service.Mask("id").GetGlobalIpRecords()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the global ips of the account: %s", err)
+	}
+
+	vlanService := services.GetAccountService(sess).Mask(accountLimitsVlanMask)
+	if dc, ok := d.GetOk("datacenter"); ok {
+		vlanService = vlanService.Filter(filter.Path("networkVlans.primaryRouter.datacenter.name").Eq(dc.(string)).Build())
+	}
+	vlans, err := getAccountNetworkVlans(vlanService)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the vlans of the account: %s", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("hourly_instance_limit", instanceLimit)
+	d.Set("hourly_instance_count", len(guests))
+	d.Set("hourly_server_limit", serverLimit)
+	d.Set("hourly_server_count", len(servers))
+	d.Set("global_ip_count", len(globalIps))
+	d.Set("routers", flattenRouterVlanCounts(vlans))
+	d.Set("vlans", flattenVlanSubnetCounts(vlans))
+
+	return nil
+}
+
+// flattenRouterVlanCounts returns the number of VLANs by router, in the order of the router hostnames
+func flattenRouterVlanCounts(vlans []datatypes.Network_Vlan) []map[string]interface{} {
+	counts := map[string]int{}
+	datacenters := map[string]string{}
+	for _, vlan := range vlans {
+		hostname := sl.Grab(vlan, "PrimaryRouter.Hostname", "").(string)
+		if hostname == "" {
+			continue
+		}
+		counts[hostname]++
+		datacenters[hostname] = sl.Grab(vlan, "PrimaryRouter.Datacenter.Name", "").(string)
+	}
+
+	hostnames := make([]string, 0, len(counts))
+	for hostname := range counts {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+
+	result := make([]map[string]interface{}, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		result = append(result, map[string]interface{}{
+			"hostname":   hostname,
+			"datacenter": datacenters[hostname],
+			"vlan_count": counts[hostname],
+		})
+	}
+	return result
+}
+
+// flattenVlanSubnetCounts returns the number of subnets of the VLANs, in the order of their IDs
+func flattenVlanSubnetCounts(vlans []datatypes.Network_Vlan) []map[string]interface{} {
+	sort.Slice(vlans, func(i, j int) bool {
+		return sl.Get(vlans[i].Id, 0).(int) < sl.Get(vlans[j].Id, 0).(int)
+	})
+
+	result := make([]map[string]interface{}, 0, len(vlans))
+	for _, vlan := range vlans {
+		result = append(result, map[string]interface{}{
+			"id":              sl.Get(vlan.Id, 0),
+			"vlan_number":     sl.Get(vlan.VlanNumber, 0),
+			"router_hostname": sl.Grab(vlan, "PrimaryRouter.Hostname", ""),
+			"subnet_count":    int(sl.Get(vlan.SubnetCount, uint(0)).(uint)),
+		})
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+package ibm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMAccountLimitsDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAccountLimitsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_account_limits.dal06", "hourly_instance_limit"),
+					resource.TestCheckResourceAttrSet("data.ibm_account_limits.dal06", "hourly_server_limit"),
+					resource.TestCheckResourceAttrSet("data.ibm_account_limits.dal06", "global_ip_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_account_limits.dal06", "routers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testLimitsVlan(id int, router string, subnets uint) datatypes.Network_Vlan {
+	vlan := datatypes.Network_Vlan{Id: sl.Int(id), VlanNumber: sl.Int(1000 + id), SubnetCount: sl.Uint(subnets)}
+	if router != "" {
+		vlan.PrimaryRouter = &datatypes.Hardware_Router{
+			Hardware_Switch: datatypes.Hardware_Switch{
+				Hardware: datatypes.Hardware{
+					Hostname:   sl.String(router),
+					Datacenter: &datatypes.Location{Name: sl.String("dal06")},
+				},
+			},
+		}
+	}
+	return vlan
+}
+
+func TestFlattenRouterVlanCounts(t *testing.T) {
+	routers := flattenRouterVlanCounts([]datatypes.Network_Vlan{
+		testLimitsVlan(1, "fcr01a.dal06", 1),
+		testLimitsVlan(2, "bcr01a.dal06", 2),
+		testLimitsVlan(3, "fcr01a.dal06", 0),
+		testLimitsVlan(4, "", 0),
+	})
+	expected := []map[string]interface{}{
+		{"hostname": "bcr01a.dal06", "datacenter": "dal06", "vlan_count": 1},
+		{"hostname": "fcr01a.dal06", "datacenter": "dal06", "vlan_count": 2},
+	}
+	if !reflect.DeepEqual(routers, expected) {
+		t.Errorf("Expected %v, got %v", expected, routers)
+	}
+}
+
+func TestFlattenVlanSubnetCounts(t *testing.T) {
+	vlans := flattenVlanSubnetCounts([]datatypes.Network_Vlan{
+		testLimitsVlan(2, "bcr01a.dal06", 2),
+		testLimitsVlan(1, "fcr01a.dal06", 1),
+		{Id: sl.Int(3)},
+	})
+	expected := []map[string]interface{}{
+		{"id": 1, "vlan_number": 1001, "router_hostname": "fcr01a.dal06", "subnet_count": 1},
+		{"id": 2, "vlan_number": 1002, "router_hostname": "bcr01a.dal06", "subnet_count": 2},
+		{"id": 3, "vlan_number": 0, "router_hostname": "", "subnet_count": 0},
+	}
+	if !reflect.DeepEqual(vlans, expected) {
+		t.Errorf("Expected %v, got %v", expected, vlans)
+	}
+}
+
+const testAccCheckIBMAccountLimitsDataSourceConfig = `
+data "ibm_account_limits" "dal06" {
+    datacenter = "dal06"
+}
+`
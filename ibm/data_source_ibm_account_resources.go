@@ -0,0 +1,277 @@
+package ibm
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const (
+	accountResourcesVlanMask   = "id,name,vlanNumber,networkSpace,primaryRouter[hostname,datacenter[name]],networkVlanFirewall[id]"
+	accountResourcesServerMask = "id,hostname,domain,datacenter[name]"
+)
+
+// invalidResourceNameChars are the characters which cannot be used in the name of a resource
+var invalidResourceNameChars = regexp.MustCompile("[^a-zA-Z0-9_-]")
+
+func dataSourceIBMAccountResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMAccountResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"datacenter": {
+				Description: "The datacenter of the resources, all the datacenters when not set",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"vlans": {
+				Description: "The VLANs of the account",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"router_hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datacenter": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"vm_instances": {
+				Description: "The virtual guests of the account",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        accountResourcesServerSchema(),
+			},
+
+			"bare_metals": {
+				Description: "The bare metal servers of the account",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        accountResourcesServerSchema(),
+			},
+
+			"firewalls": {
+				Description: "The dedicated firewalls of the account",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"public_vlan_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"datacenter": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"import_commands": {
+				Description: "The terraform import commands of the resources",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func accountResourcesServerSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datacenter": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMAccountResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	dc := d.Get("datacenter").(string)
+
+	vlanService := services.GetAccountService(sess).Mask(accountResourcesVlanMask)
+	guestService := services.GetAccountService(sess).Mask(accountResourcesServerMask)
+	hardwareService := services.GetAccountService(sess).Mask(accountResourcesServerMask)
+	if dc != "" {
+		vlanService = vlanService.Filter(filter.Path("networkVlans.primaryRouter.datacenter.name").Eq(dc).Build())
+		guestService = guestService.Filter(filter.Path("virtualGuests.datacenter.name").Eq(dc).Build())
+		hardwareService = hardwareService.Filter(filter.Path("hardware.datacenter.name").Eq(dc).Build())
+	}
+
+	vlans, err := getAccountNetworkVlans(vlanService)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the vlans of the account: %s", err)
+	}
+	guests, err := getAccountVirtualGuests(guestService)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the virtual guests of the account: %s", err)
+	}
+	hardware, err := getAccountHardware(hardwareService)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the hardware of the account: %s", err)
+	}
+
+	vlanList, firewallList := flattenAccountVlans(vlans)
+	guestList := flattenAccountVirtualGuests(guests)
+	hardwareList := flattenAccountHardware(hardware)
+
+	commands := make([]string, 0)
+	commands = append(commands, importCommands("ibm_network_vlan", vlanList, "vlan", "name")...)
+	commands = append(commands, importCommands("ibm_firewall", firewallList, "firewall", "")...)
+	commands = append(commands, importCommands("ibm_compute_vm_instance", guestList, "vm", "hostname")...)
+	commands = append(commands, importCommands("ibm_compute_bare_metal", hardwareList, "bare_metal", "hostname")...)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("vlans", vlanList)
+	d.Set("firewalls", firewallList)
+	d.Set("vm_instances", guestList)
+	d.Set("bare_metals", hardwareList)
+	d.Set("import_commands", commands)
+
+	return nil
+}
+
+// flattenAccountVlans returns the VLANs and their dedicated firewalls, in the order of their IDs
+func flattenAccountVlans(vlans []datatypes.Network_Vlan) ([]map[string]interface{}, []map[string]interface{}) {
+	sort.Slice(vlans, func(i, j int) bool {
+		return sl.Get(vlans[i].Id, 0).(int) < sl.Get(vlans[j].Id, 0).(int)
+	})
+
+	vlanList := make([]map[string]interface{}, 0, len(vlans))
+	firewallList := make([]map[string]interface{}, 0)
+	for _, vlan := range vlans {
+		dc := sl.Grab(vlan, "PrimaryRouter.Datacenter.Name", "")
+		vlanList = append(vlanList, map[string]interface{}{
+			"id":              sl.Get(vlan.Id, 0),
+			"name":            sl.Get(vlan.Name, ""),
+			"vlan_number":     sl.Get(vlan.VlanNumber, 0),
+			"type":            sl.Get(vlan.NetworkSpace, ""),
+			"router_hostname": sl.Grab(vlan, "PrimaryRouter.Hostname", ""),
+			"datacenter":      dc,
+		})
+		if vlan.NetworkVlanFirewall != nil && vlan.NetworkVlanFirewall.Id != nil {
+			firewallList = append(firewallList, map[string]interface{}{
+				"id":             *vlan.NetworkVlanFirewall.Id,
+				"public_vlan_id": sl.Get(vlan.Id, 0),
+				"datacenter":     dc,
+			})
+		}
+	}
+	return vlanList, firewallList
+}
+
+// flattenAccountVirtualGuests returns the virtual guests in the order of their IDs
+func flattenAccountVirtualGuests(guests []datatypes.Virtual_Guest) []map[string]interface{} {
+	sort.Slice(guests, func(i, j int) bool {
+		return sl.Get(guests[i].Id, 0).(int) < sl.Get(guests[j].Id, 0).(int)
+	})
+
+	result := make([]map[string]interface{}, 0, len(guests))
+	for _, guest := range guests {
+		result = append(result, map[string]interface{}{
+			"id":         sl.Get(guest.Id, 0),
+			"hostname":   sl.Get(guest.Hostname, ""),
+			"domain":     sl.Get(guest.Domain, ""),
+			"datacenter": sl.Grab(guest, "Datacenter.Name", ""),
+		})
+	}
+	return result
+}
+
+// flattenAccountHardware returns the hardware in the order of their IDs
+func flattenAccountHardware(hardware []datatypes.Hardware) []map[string]interface{} {
+	sort.Slice(hardware, func(i, j int) bool {
+		return sl.Get(hardware[i].Id, 0).(int) < sl.Get(hardware[j].Id, 0).(int)
+	})
+
+	result := make([]map[string]interface{}, 0, len(hardware))
+	for _, hw := range hardware {
+		result = append(result, map[string]interface{}{
+			"id":         sl.Get(hw.Id, 0),
+			"hostname":   sl.Get(hw.Hostname, ""),
+			"domain":     sl.Get(hw.Domain, ""),
+			"datacenter": sl.Grab(hw, "Datacenter.Name", ""),
+		})
+	}
+	return result
+}
+
+// importCommands returns the terraform import commands of the resources. The resources are named
+// after their nameKey attribute, or after prefix and their ID when it is empty.
+func importCommands(resourceType string, resources []map[string]interface{}, prefix, nameKey string) []string {
+	commands := make([]string, 0, len(resources))
+	used := map[string]bool{}
+	for _, r := range resources {
+		id := r["id"].(int)
+		name := ""
+		if nameKey != "" {
+			name = importResourceName(r[nameKey].(string))
+		}
+		if name == "" || used[name] {
+			name = fmt.Sprintf("%s_%d", prefix, id)
+		}
+		used[name] = true
+		commands = append(commands, fmt.Sprintf("terraform import %s.%s %d", resourceType, name, id))
+	}
+	return commands
+}
+
+// importResourceName returns a valid resource name from the name of an existing resource
+func importResourceName(name string) string {
+	name = invalidResourceNameChars.ReplaceAllString(strings.TrimSpace(name), "_")
+	if name != "" && !strings.ContainsAny(name[:1], "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ_") {
+		name = "_" + name
+	}
+	return name
+}
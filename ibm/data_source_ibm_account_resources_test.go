@@ -0,0 +1,86 @@
+package ibm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMAccountResourcesDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAccountResourcesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_account_resources.dal06", "vlans.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_account_resources.dal06", "vm_instances.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_account_resources.dal06", "import_commands.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenAccountVlans(t *testing.T) {
+	firewalled := datatypes.Network_Vlan{
+		Id:           sl.Int(20),
+		Name:         sl.String("web"),
+		VlanNumber:   sl.Int(1120),
+		NetworkSpace: sl.String("PUBLIC"),
+		PrimaryRouter: &datatypes.Hardware_Router{
+			Hardware_Switch: datatypes.Hardware_Switch{
+				Hardware: datatypes.Hardware{
+					Hostname:   sl.String("fcr01a.dal06"),
+					Datacenter: &datatypes.Location{Name: sl.String("dal06")},
+				},
+			},
+		},
+		NetworkVlanFirewall: &datatypes.Network_Vlan_Firewall{Id: sl.Int(200)},
+	}
+	vlans, firewalls := flattenAccountVlans([]datatypes.Network_Vlan{
+		firewalled,
+		{Id: sl.Int(10), VlanNumber: sl.Int(1110), NetworkSpace: sl.String("PRIVATE")},
+	})
+
+	if len(vlans) != 2 || vlans[0]["id"] != 10 || vlans[1]["id"] != 20 {
+		t.Fatalf("Expected the vlans in the order of their IDs, got %v", vlans)
+	}
+	if vlans[1]["router_hostname"] != "fcr01a.dal06" || vlans[1]["datacenter"] != "dal06" {
+		t.Errorf("Expected the router and datacenter of the vlan, got %v", vlans[1])
+	}
+	expected := []map[string]interface{}{
+		{"id": 200, "public_vlan_id": 20, "datacenter": "dal06"},
+	}
+	if !reflect.DeepEqual(firewalls, expected) {
+		t.Errorf("Expected firewalls %v, got %v", expected, firewalls)
+	}
+}
+
+func TestImportCommands(t *testing.T) {
+	resources := []map[string]interface{}{
+		{"id": 1, "hostname": "web01"},
+		{"id": 2, "hostname": "web01"},
+		{"id": 3, "hostname": "1st.db"},
+		{"id": 4, "hostname": ""},
+	}
+	expected := []string{
+		"terraform import ibm_compute_vm_instance.web01 1",
+		"terraform import ibm_compute_vm_instance.vm_2 2",
+		"terraform import ibm_compute_vm_instance._1st_db 3",
+		"terraform import ibm_compute_vm_instance.vm_4 4",
+	}
+	if commands := importCommands("ibm_compute_vm_instance", resources, "vm", "hostname"); !reflect.DeepEqual(commands, expected) {
+		t.Errorf("Expected %v, got %v", expected, commands)
+	}
+}
+
+const testAccCheckIBMAccountResourcesDataSourceConfig = `
+data "ibm_account_resources" "dal06" {
+    datacenter = "dal06"
+}
+`
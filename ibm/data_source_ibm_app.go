@@ -100,15 +100,11 @@ func dataSourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	if len(route) > 0 {
-		d.Set("route_guid", flattenRoute(route))
-	}
+	d.Set("route_guid", flattenRoute(route))
 	svcBindings, err := appAPI.ListServiceBindings(app.GUID)
 	if err != nil {
 		return err
 	}
-	if len(svcBindings) > 0 {
-		d.Set("service_instance_guid", flattenServiceBindings(svcBindings))
-	}
+	d.Set("service_instance_guid", flattenServiceBindings(svcBindings))
 	return nil
 }
@@ -16,6 +16,11 @@ func dataSourceIBMAppDomainPrivate() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"org_guid": {
+				Description: "The GUID of the organization that owns the private domain",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -31,6 +36,7 @@ func dataSourceIBMAppDomainPrivateRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error retrieving domain: %s", err)
 	}
 	d.SetId(prdomain.GUID)
+	d.Set("org_guid", prdomain.OwningOrganizationGUID)
 	return nil
 
 }
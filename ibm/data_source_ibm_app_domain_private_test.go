@@ -20,6 +20,9 @@ func TestAccIBMAppDomainPrivateDataSource_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
 						"data.ibm_app_domain_private.testacc_domain", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_app_domain_private.testacc_domain", "org_guid",
+						"ibm_app_domain_private.domain", "org_guid"),
 				),
 			},
 		},
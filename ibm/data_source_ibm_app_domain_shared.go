@@ -17,6 +17,16 @@ func dataSourceIBMAppDomainShared() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validateDomainName,
 			},
+			"router_group_guid": {
+				Description: "The GUID of the router group of the shared domain",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"router_group_type": {
+				Description: "The type of the router group of the shared domain",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -32,6 +42,8 @@ func dataSourceIBMAppDomainSharedRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error retrieving shared domain: %s", err)
 	}
 	d.SetId(shdomain.GUID)
+	d.Set("router_group_guid", shdomain.RouterGroupGUID)
+	d.Set("router_group_type", shdomain.RouterGroupType)
 	return nil
 
 }
@@ -0,0 +1,169 @@
+package ibm
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const billingUsageItemMask = "id,categoryCode,description,hostName,domainName,nextInvoiceTotalRecurringAmount"
+
+func dataSourceIBMBillingUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMBillingUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"balance": {
+				Description: "The balance of the account",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+
+			"total_recurring_amount": {
+				Description: "The total recurring amount of the next invoice",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+
+			"total_one_time_amount": {
+				Description: "The total one time amount of the next invoice",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+
+			"categories": {
+				Description: "The recurring charges of the next invoice by product category",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"item_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"items": {
+				Description: "The recurring charges of the next invoice by resource",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"category_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMBillingUsageRead(d *schema.ResourceData, meta interface{}) error {
+	service := services.GetAccountService(meta.(ClientSession).SoftLayerSession())
+
+	balance, err := service.GetBalance()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the balance of the account: %s", err)
+	}
+	recurring, err := service.GetNextInvoiceTotalRecurringAmount()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the recurring amount of the next invoice: %s", err)
+	}
+	oneTime, err := service.GetNextInvoiceTotalOneTimeAmount()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the one time amount of the next invoice: %s", err)
+	}
+
+	items, err := getAccountNextInvoiceTopLevelBillingItems(service.Mask(billingUsageItemMask))
+	if err != nil {
+		return fmt.Errorf("Error retrieving the billing items of the next invoice: %s", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("balance", float64(balance))
+	d.Set("total_recurring_amount", float64(recurring))
+	d.Set("total_one_time_amount", float64(oneTime))
+	d.Set("categories", flattenBillingUsageCategories(items))
+	d.Set("items", flattenBillingUsageItems(items))
+
+	return nil
+}
+
+func flattenBillingUsageItems(items []datatypes.Billing_Item) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		resourceName := sl.Get(item.HostName, "").(string)
+		if domain := sl.Get(item.DomainName, "").(string); resourceName != "" && domain != "" {
+			resourceName = resourceName + "." + domain
+		}
+		result = append(result, map[string]interface{}{
+			"id":            sl.Get(item.Id, 0),
+			"category_code": sl.Get(item.CategoryCode, ""),
+			"description":   sl.Get(item.Description, ""),
+			"resource_name": resourceName,
+			"amount":        float64(sl.Get(item.NextInvoiceTotalRecurringAmount, datatypes.Float64(0)).(datatypes.Float64)),
+		})
+	}
+	return result
+}
+
+// flattenBillingUsageCategories sums the recurring amounts of the billing items by category, in
+// the order of the category codes
+func flattenBillingUsageCategories(items []datatypes.Billing_Item) []map[string]interface{} {
+	amounts := make(map[string]float64)
+	counts := make(map[string]int)
+	for _, item := range items {
+		code := sl.Get(item.CategoryCode, "").(string)
+		amounts[code] += float64(sl.Get(item.NextInvoiceTotalRecurringAmount, datatypes.Float64(0)).(datatypes.Float64))
+		counts[code]++
+	}
+
+	codes := make([]string, 0, len(amounts))
+	for code := range amounts {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	result := make([]map[string]interface{}, 0, len(codes))
+	for _, code := range codes {
+		result = append(result, map[string]interface{}{
+			"category_code": code,
+			"amount":        amounts[code],
+			"item_count":    counts[code],
+		})
+	}
+	return result
+}
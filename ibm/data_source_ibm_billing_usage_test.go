@@ -0,0 +1,65 @@
+package ibm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMBillingUsageDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMBillingUsageDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_billing_usage.usage", "total_recurring_amount"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_usage.usage", "categories.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_usage.usage", "items.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenBillingUsage(t *testing.T) {
+	amount := func(v float64) *datatypes.Float64 {
+		f := datatypes.Float64(v)
+		return &f
+	}
+	items := []datatypes.Billing_Item{
+		{Id: sl.Int(1), CategoryCode: sl.String("guest_core"), Description: sl.String("2 x 2.0 GHz Cores"),
+			HostName: sl.String("web"), DomainName: sl.String("example.com"), NextInvoiceTotalRecurringAmount: amount(20.5)},
+		{Id: sl.Int(2), CategoryCode: sl.String("network_vlan"), Description: sl.String("Public Network Vlan"),
+			NextInvoiceTotalRecurringAmount: amount(10)},
+		{Id: sl.Int(3), CategoryCode: sl.String("guest_core"), Description: sl.String("1 x 2.0 GHz Core"),
+			HostName: sl.String("db"), DomainName: sl.String("example.com"), NextInvoiceTotalRecurringAmount: amount(9.5)},
+	}
+
+	expectedCategories := []map[string]interface{}{
+		{"category_code": "guest_core", "amount": 30.0, "item_count": 2},
+		{"category_code": "network_vlan", "amount": 10.0, "item_count": 1},
+	}
+	if categories := flattenBillingUsageCategories(items); !reflect.DeepEqual(categories, expectedCategories) {
+		t.Errorf("Expected categories %v, got %v", expectedCategories, categories)
+	}
+
+	flattened := flattenBillingUsageItems(items)
+	if len(flattened) != 3 {
+		t.Fatalf("Expected 3 items, got %d", len(flattened))
+	}
+	if flattened[0]["resource_name"] != "web.example.com" || flattened[1]["resource_name"] != "" {
+		t.Errorf("Unexpected resource names %v and %v", flattened[0]["resource_name"], flattened[1]["resource_name"])
+	}
+	if flattened[0]["amount"] != 20.5 {
+		t.Errorf("Expected amount 20.5, got %v", flattened[0]["amount"])
+	}
+}
+
+const testAccCheckIBMBillingUsageDataSourceConfig = `
+data "ibm_billing_usage" "usage" {}
+`
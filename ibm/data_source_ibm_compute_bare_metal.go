@@ -0,0 +1,271 @@
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const bareMetalInventoryMask = "id,hostname,domain,datacenter[name],hardwareStatus[status]," +
+	"primaryIpAddress,primaryBackendIpAddress,operatingSystemReferenceCode," +
+	"processorPhysicalCoreAmount,processors[hardwareComponentModel[hardwareGenericComponentModel[capacity,units,description]]]," +
+	"memoryCapacity,hardDrives[hardwareComponentModel[hardwareGenericComponentModel[capacity,units,description]]]," +
+	"networkComponents[id,name,port,macAddress,primaryIpAddress,maxSpeed,status,networkVlan[id]]," +
+	"remoteManagementComponent[ipmiIpAddress,ipmiMacAddress]"
+
+func dataSourceIBMComputeBareMetal() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMComputeBareMetalRead,
+
+		Schema: map[string]*schema.Schema{
+			"bare_metal_id": {
+				Description:   "The ID of the bare metal server",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"hostname", "domain"},
+			},
+
+			"hostname": {
+				Description: "The hostname of the bare metal server",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"domain": {
+				Description: "The domain of the bare metal server",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"datacenter": {
+				Description: "Datacenter in which the bare metal server is deployed",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"status": {
+				Description: "The status of the bare metal server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"os_reference_code": {
+				Description: "The reference code of the operating system of the bare metal server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"public_ipv4_address": {
+				Description: "The public IPv4 address of the bare metal server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"private_ipv4_address": {
+				Description: "The private IPv4 address of the bare metal server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"processor_core_amount": {
+				Description: "The number of physical processor cores",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"processors": {
+				Description: "The processors of the bare metal server",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: hardwareComponentSchema()},
+			},
+
+			"memory": {
+				Description: "The amount of memory, in gigabytes",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"disks": {
+				Description: "The hard drives of the bare metal server",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: hardwareComponentSchema()},
+			},
+
+			"network_components": {
+				Description: "The network components of the bare metal server",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_speed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"remote_management_ip_address": {
+				Description: "The IP address of the remote management (IPMI) interface",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"remote_management_mac_address": {
+				Description: "The MAC address of the remote management (IPMI) interface",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func hardwareComponentSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"capacity": {
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+		"units": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	var bm datatypes.Hardware
+	if id, ok := d.GetOk("bare_metal_id"); ok {
+		var err error
+		bm, err = services.GetHardwareService(sess).Id(id.(int)).Mask(bareMetalInventoryMask).GetObject()
+		if err != nil {
+			return fmt.Errorf("Error retrieving bare metal server %d: %s", id.(int), err)
+		}
+	} else {
+		hostname := d.Get("hostname").(string)
+		domain := d.Get("domain").(string)
+		if hostname == "" {
+			return fmt.Errorf("One of bare_metal_id or hostname must be set")
+		}
+
+		filters := []filter.Filter{filter.Path("hardware.hostname").Eq(hostname)}
+		if domain != "" {
+			filters = append(filters, filter.Path("hardware.domain").Eq(domain))
+		}
+		bms, err := getAccountHardware(services.GetAccountService(sess).
+			Filter(filter.Build(filters...)).
+			Mask(bareMetalInventoryMask))
+		if err != nil {
+			return fmt.Errorf("Error retrieving bare metal server details for host %s: %s", hostname, err)
+		}
+		if len(bms) == 0 {
+			return fmt.Errorf("No bare metal server with hostname %s and domain %s", hostname, domain)
+		}
+		if len(bms) > 1 {
+			return fmt.Errorf(
+				"More than one bare metal server found with host matching [%s] and domain matching [%s]. "+
+					"Set bare_metal_id to select the bare metal server to use", hostname, domain)
+		}
+		bm = bms[0]
+	}
+
+	d.SetId(fmt.Sprintf("%d", *bm.Id))
+	d.Set("bare_metal_id", *bm.Id)
+	d.Set("hostname", sl.Get(bm.Hostname, ""))
+	d.Set("domain", sl.Get(bm.Domain, ""))
+	d.Set("datacenter", sl.Grab(bm, "Datacenter.Name", ""))
+	d.Set("status", sl.Grab(bm, "HardwareStatus.Status", ""))
+	d.Set("os_reference_code", sl.Get(bm.OperatingSystemReferenceCode, ""))
+	d.Set("public_ipv4_address", sl.Get(bm.PrimaryIpAddress, ""))
+	d.Set("private_ipv4_address", sl.Get(bm.PrimaryBackendIpAddress, ""))
+
+	d.Set("processor_core_amount", int(sl.Get(bm.ProcessorPhysicalCoreAmount, uint(0)).(uint)))
+	d.Set("processors", flattenHardwareComponents(bm.Processors))
+	d.Set("memory", int(sl.Get(bm.MemoryCapacity, uint(0)).(uint)))
+	d.Set("disks", flattenHardwareComponents(bm.HardDrives))
+	d.Set("network_components", flattenBareMetalNetworkComponents(bm.NetworkComponents))
+
+	if bm.RemoteManagementComponent != nil {
+		d.Set("remote_management_ip_address", sl.Get(bm.RemoteManagementComponent.IpmiIpAddress, ""))
+		d.Set("remote_management_mac_address", sl.Get(bm.RemoteManagementComponent.IpmiMacAddress, ""))
+	}
+
+	return nil
+}
+
+func flattenHardwareComponents(components []datatypes.Hardware_Component) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(components))
+	for _, component := range components {
+		model, ok := sl.GrabOk(component, "HardwareComponentModel.HardwareGenericComponentModel")
+		if !ok {
+			continue
+		}
+		genericModel := model.(datatypes.Hardware_Component_Model_Generic)
+		result = append(result, map[string]interface{}{
+			"description": sl.Get(genericModel.Description, ""),
+			"capacity":    float64(sl.Get(genericModel.Capacity, datatypes.Float64(0)).(datatypes.Float64)),
+			"units":       sl.Get(genericModel.Units, ""),
+		})
+	}
+	return result
+}
+
+func flattenBareMetalNetworkComponents(components []datatypes.Network_Component) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(components))
+	for _, component := range components {
+		result = append(result, map[string]interface{}{
+			"id":          sl.Get(component.Id, 0),
+			"name":        sl.Get(component.Name, ""),
+			"port":        sl.Get(component.Port, 0),
+			"mac_address": sl.Get(component.MacAddress, ""),
+			"ip_address":  sl.Get(component.PrimaryIpAddress, ""),
+			"max_speed":   sl.Get(component.MaxSpeed, 0),
+			"status":      sl.Get(component.Status, ""),
+			"vlan_id":     sl.Grab(component, "NetworkVlan.Id", 0),
+		})
+	}
+	return result
+}
@@ -0,0 +1,52 @@
+package ibm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMComputeBareMetalDataSource_basic(t *testing.T) {
+	hostname := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMComputeBareMetalDataSourceConfigBasic(hostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_compute_bare_metal.tf-bm-ds-acc-test", "id",
+						"ibm_compute_bare_metal.tf-bm-acc-test", "id"),
+					resource.TestCheckResourceAttr("data.ibm_compute_bare_metal.tf-bm-ds-acc-test", "datacenter", "dal01"),
+					resource.TestCheckResourceAttr("data.ibm_compute_bare_metal.tf-bm-ds-acc-test", "memory", "32"),
+					resource.TestCheckResourceAttr("data.ibm_compute_bare_metal.tf-bm-ds-acc-test", "disks.#", "1"),
+					resource.TestMatchResourceAttr("data.ibm_compute_bare_metal.tf-bm-ds-acc-test", "network_components.#", regexp.MustCompile("^[1-9][0-9]*$")),
+					resource.TestCheckResourceAttrSet("data.ibm_compute_bare_metal.tf-bm-ds-acc-test", "remote_management_ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMComputeBareMetalDataSourceConfigBasic(hostname string) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_bare_metal" "tf-bm-acc-test" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "UBUNTU_16_64"
+    datacenter = "dal01"
+    network_speed = 100
+    hourly_billing = true
+    private_network_only = false
+    fixed_config_preset = "S1270_32GB_1X1TBSATA_NORAID"
+}
+
+data "ibm_compute_bare_metal" "tf-bm-ds-acc-test" {
+    hostname = "${ibm_compute_bare_metal.tf-bm-acc-test.hostname}"
+    domain = "${ibm_compute_bare_metal.tf-bm-acc-test.domain}"
+}`, hostname)
+}
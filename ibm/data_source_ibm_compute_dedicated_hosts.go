@@ -0,0 +1,173 @@
+package ibm
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const dedicatedHostsMask = "id,name,cpuCount,memoryCapacity,diskCapacity,guestCount,datacenter[name]," +
+	"backendRouter[hostname],allocationStatus"
+
+func dataSourceIBMComputeDedicatedHosts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMComputeDedicatedHostsRead,
+
+		Schema: map[string]*schema.Schema{
+			"datacenter": {
+				Description: "The datacenter in which to look for the dedicated hosts, all the datacenters when not set",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"min_cpu_available": {
+				Description:  "The minimum number of available CPU cores of the dedicated hosts",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateIntegerInRange(0, 1024),
+			},
+
+			"min_memory_available": {
+				Description:  "The minimum available memory of the dedicated hosts, in GB",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateIntegerInRange(0, 65536),
+			},
+
+			"hosts": {
+				Description: "The dedicated hosts of the account, ordered by the most available CPU cores and memory",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datacenter": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backend_router": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"guest_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cpu_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cpu_available": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_available": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_available": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"host_ids": {
+				Description: "The IDs of the dedicated hosts, in the order of hosts",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceIBMComputeDedicatedHostsRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	service := services.GetAccountService(sess).Mask(dedicatedHostsMask)
+
+	dc := d.Get("datacenter").(string)
+	if dc != "" {
+		service = service.Filter(filter.Path("dedicatedHosts.datacenter.name").Eq(dc).Build())
+	}
+
+	dedicatedHosts, err := service.GetDedicatedHosts()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the dedicated hosts of the account: %s", err)
+	}
+
+	hosts := flattenDedicatedHosts(dedicatedHosts, d.Get("min_cpu_available").(int), d.Get("min_memory_available").(int))
+	hostIds := make([]int, 0, len(hosts))
+	for _, host := range hosts {
+		hostIds = append(hostIds, host["id"].(int))
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("hosts", hosts)
+	d.Set("host_ids", hostIds)
+
+	return nil
+}
+
+// flattenDedicatedHosts returns the dedicated hosts with at least the available CPU cores and
+// memory, ordered by the most available CPU cores, then memory, then by ID, so that the first host
+// is the one with the most room for new guests
+func flattenDedicatedHosts(dedicatedHosts []datatypes.Virtual_DedicatedHost, minCpu, minMemory int) []map[string]interface{} {
+	hosts := make([]map[string]interface{}, 0)
+	for _, host := range dedicatedHosts {
+		if host.Id == nil {
+			continue
+		}
+		cpuAvailable := sl.Grab(host, "AllocationStatus.CpuAvailable", 0).(int)
+		memoryAvailable := sl.Grab(host, "AllocationStatus.MemoryAvailable", 0).(int)
+		if cpuAvailable < minCpu || memoryAvailable < minMemory {
+			continue
+		}
+		hosts = append(hosts, map[string]interface{}{
+			"id":               *host.Id,
+			"name":             sl.Get(host.Name, ""),
+			"datacenter":       sl.Grab(host, "Datacenter.Name", ""),
+			"backend_router":   sl.Grab(host, "BackendRouter.Hostname", ""),
+			"guest_count":      int(sl.Get(host.GuestCount, uint(0)).(uint)),
+			"cpu_count":        sl.Get(host.CpuCount, 0),
+			"cpu_available":    cpuAvailable,
+			"memory_capacity":  sl.Get(host.MemoryCapacity, 0),
+			"memory_available": memoryAvailable,
+			"disk_capacity":    sl.Get(host.DiskCapacity, 0),
+			"disk_available":   sl.Grab(host, "AllocationStatus.DiskAvailable", 0),
+		})
+	}
+
+	sort.SliceStable(hosts, func(i, j int) bool {
+		if hosts[i]["cpu_available"].(int) != hosts[j]["cpu_available"].(int) {
+			return hosts[i]["cpu_available"].(int) > hosts[j]["cpu_available"].(int)
+		}
+		if hosts[i]["memory_available"].(int) != hosts[j]["memory_available"].(int) {
+			return hosts[i]["memory_available"].(int) > hosts[j]["memory_available"].(int)
+		}
+		return hosts[i]["id"].(int) < hosts[j]["id"].(int)
+	})
+	return hosts
+}
@@ -0,0 +1,94 @@
+package ibm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMComputeDedicatedHostsDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMComputeDedicatedHostsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_compute_dedicated_hosts.dal06", "hosts.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_compute_dedicated_hosts.dal06", "host_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testDedicatedHost(id, cpuAvailable, memoryAvailable int) datatypes.Virtual_DedicatedHost {
+	return datatypes.Virtual_DedicatedHost{
+		Id:             sl.Int(id),
+		Name:           sl.String("host"),
+		CpuCount:       sl.Int(56),
+		MemoryCapacity: sl.Int(242),
+		DiskCapacity:   sl.Int(1200),
+		GuestCount:     sl.Uint(2),
+		Datacenter:     &datatypes.Location{Name: sl.String("dal06")},
+		AllocationStatus: &datatypes.Container_Virtual_DedicatedHost_AllocationStatus{
+			CpuAvailable:    sl.Int(cpuAvailable),
+			MemoryAvailable: sl.Int(memoryAvailable),
+			DiskAvailable:   sl.Int(1000),
+		},
+	}
+}
+
+func TestFlattenDedicatedHosts(t *testing.T) {
+	dedicatedHosts := []datatypes.Virtual_DedicatedHost{
+		testDedicatedHost(1, 8, 64),
+		testDedicatedHost(2, 16, 32),
+		testDedicatedHost(3, 16, 128),
+		testDedicatedHost(4, 2, 4),
+		testDedicatedHost(5, 8, 64),
+	}
+
+	hosts := flattenDedicatedHosts(dedicatedHosts, 0, 0)
+	ids := make([]int, 0, len(hosts))
+	for _, host := range hosts {
+		ids = append(ids, host["id"].(int))
+	}
+	if !reflect.DeepEqual(ids, []int{3, 2, 1, 5, 4}) {
+		t.Errorf("Expected hosts [3 2 1 5 4], got %v", ids)
+	}
+
+	expected := map[string]interface{}{
+		"id":               3,
+		"name":             "host",
+		"datacenter":       "dal06",
+		"backend_router":   "",
+		"guest_count":      2,
+		"cpu_count":        56,
+		"cpu_available":    16,
+		"memory_capacity":  242,
+		"memory_available": 128,
+		"disk_capacity":    1200,
+		"disk_available":   1000,
+	}
+	if !reflect.DeepEqual(hosts[0], expected) {
+		t.Errorf("Expected host %v, got %v", expected, hosts[0])
+	}
+
+	hosts = flattenDedicatedHosts(dedicatedHosts, 8, 64)
+	ids = make([]int, 0, len(hosts))
+	for _, host := range hosts {
+		ids = append(ids, host["id"].(int))
+	}
+	if !reflect.DeepEqual(ids, []int{3, 1, 5}) {
+		t.Errorf("Expected hosts [3 1 5] with 8 cores and 64 GB available, got %v", ids)
+	}
+}
+
+const testAccCheckIBMComputeDedicatedHostsDataSourceConfig = `
+data "ibm_compute_dedicated_hosts" "dal06" {
+    datacenter = "dal06"
+}
+`
@@ -36,9 +36,8 @@ func dataSourceIBMComputeImageTemplateRead(d *schema.ResourceData, meta interfac
 
 	name := d.Get("name").(string)
 
-	imageTemplates, err := service.
-		Mask("id,name").
-		GetBlockDeviceTemplateGroups()
+	imageTemplates, err := getAccountBlockDeviceTemplateGroups(service.
+		Mask("id,name"))
 	if err != nil {
 		return fmt.Errorf("Error looking up image template [%s]: %s", name, err)
 	}
@@ -58,10 +58,9 @@ func dataSourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) er
 	label := d.Get("label").(string)
 	mostRecent := d.Get("most_recent").(bool)
 
-	keys, err := service.
+	keys, err := getAccountSshKeys(service.
 		Filter(filter.Build(filter.Path("sshKeys.label").Eq(label))).
-		Mask("id,label,key,fingerprint,notes,createDate").
-		GetSshKeys()
+		Mask("id,label,key,fingerprint,notes,createDate"))
 
 	if err != nil {
 		return fmt.Errorf("Error retrieving SSH key: %s", err)
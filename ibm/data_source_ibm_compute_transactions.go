@@ -0,0 +1,164 @@
+package ibm
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const computeTransactionMask = "id,createDate,statusChangeDate,elapsedSeconds,transactionStatus[name,friendlyName],transactionGroup[name]"
+
+func dataSourceIBMComputeTransactions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMComputeTransactionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"guest_id": {
+				Description:   "The ID of the virtual guest",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"hardware_id"},
+			},
+
+			"hardware_id": {
+				Description:   "The ID of the bare metal server",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"guest_id"},
+			},
+
+			"active_transaction_count": {
+				Description: "The number of active transactions",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"transactions": {
+				Description: "The active transactions and the last transaction",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_change_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"elapsed_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMComputeTransactionsRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	var active []datatypes.Provisioning_Version1_Transaction
+	var last datatypes.Provisioning_Version1_Transaction
+	var err error
+	var id int
+	if guestID, ok := d.GetOk("guest_id"); ok {
+		id = guestID.(int)
+		service := services.GetVirtualGuestService(sess).Id(id).Mask(computeTransactionMask)
+		active, err = service.GetActiveTransactions()
+		if err == nil {
+			last, err = service.GetLastTransaction()
+		}
+		if err != nil {
+			return fmt.Errorf("Error retrieving the transactions of virtual guest %d: %s", id, err)
+		}
+	} else if hardwareID, ok := d.GetOk("hardware_id"); ok {
+		id = hardwareID.(int)
+		service := services.GetHardwareServerService(sess).Id(id).Mask(computeTransactionMask)
+		active, err = service.GetActiveTransactions()
+		if err == nil {
+			last, err = service.GetLastTransaction()
+		}
+		if err != nil {
+			return fmt.Errorf("Error retrieving the transactions of bare metal server %d: %s", id, err)
+		}
+	} else {
+		return fmt.Errorf("One of guest_id or hardware_id must be set")
+	}
+
+	d.SetId(strconv.Itoa(id))
+	d.Set("active_transaction_count", len(active))
+	d.Set("transactions", flattenComputeTransactions(active, last))
+
+	return nil
+}
+
+// flattenComputeTransactions returns the active transactions and the last transaction, when it is
+// not active, in the order of their IDs
+func flattenComputeTransactions(active []datatypes.Provisioning_Version1_Transaction, last datatypes.Provisioning_Version1_Transaction) []map[string]interface{} {
+	transactions := make([]datatypes.Provisioning_Version1_Transaction, 0, len(active)+1)
+	activeIDs := map[int]bool{}
+	for _, transaction := range active {
+		if transaction.Id != nil {
+			activeIDs[*transaction.Id] = true
+			transactions = append(transactions, transaction)
+		}
+	}
+	if last.Id != nil && !activeIDs[*last.Id] {
+		transactions = append(transactions, last)
+	}
+	sort.Slice(transactions, func(i, j int) bool {
+		return *transactions[i].Id < *transactions[j].Id
+	})
+
+	result := make([]map[string]interface{}, 0, len(transactions))
+	for _, transaction := range transactions {
+		result = append(result, map[string]interface{}{
+			"id":                 *transaction.Id,
+			"group":              sl.Grab(transaction, "TransactionGroup.Name", ""),
+			"status":             sl.Grab(transaction, "TransactionStatus.Name", ""),
+			"status_description": sl.Grab(transaction, "TransactionStatus.FriendlyName", ""),
+			"create_date":        formatTransactionTime(transaction.CreateDate),
+			"status_change_date": formatTransactionTime(transaction.StatusChangeDate),
+			"elapsed_seconds":    sl.Get(transaction.ElapsedSeconds, 0),
+			"active":             activeIDs[*transaction.Id],
+		})
+	}
+	return result
+}
+
+func formatTransactionTime(t *datatypes.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,84 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMComputeTransactionsDataSource_Basic(t *testing.T) {
+	hostname := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMComputeTransactionsDataSourceConfig(hostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_compute_transactions.tx", "active_transaction_count", "0"),
+					resource.TestCheckResourceAttr("data.ibm_compute_transactions.tx", "transactions.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_compute_transactions.tx", "transactions.0.active", "false"),
+					resource.TestCheckResourceAttrSet("data.ibm_compute_transactions.tx", "transactions.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenComputeTransactions(t *testing.T) {
+	created := time.Date(2017, 8, 1, 10, 0, 0, 0, time.UTC)
+	active := []datatypes.Provisioning_Version1_Transaction{
+		{
+			Id:                sl.Int(12),
+			CreateDate:        &datatypes.Time{Time: created},
+			ElapsedSeconds:    sl.Int(30),
+			TransactionGroup:  &datatypes.Provisioning_Version1_Transaction_Group{Name: sl.String("Cloud Migrate")},
+			TransactionStatus: &datatypes.Provisioning_Version1_Transaction_Status{Name: sl.String("CLOUD_MIGRATE"), FriendlyName: sl.String("Migrating")},
+		},
+		{Id: sl.Int(11)},
+	}
+
+	transactions := flattenComputeTransactions(active, active[0])
+	if len(transactions) != 2 || transactions[0]["id"] != 11 || transactions[1]["id"] != 12 {
+		t.Fatalf("Expected the active transactions once, in the order of their IDs, got %v", transactions)
+	}
+	tx := transactions[1]
+	if tx["group"] != "Cloud Migrate" || tx["status"] != "CLOUD_MIGRATE" || tx["status_description"] != "Migrating" ||
+		tx["create_date"] != "2017-08-01T10:00:00Z" || tx["status_change_date"] != "" || tx["elapsed_seconds"] != 30 || tx["active"] != true {
+		t.Errorf("Unexpected transaction %v", tx)
+	}
+
+	transactions = flattenComputeTransactions(nil, datatypes.Provisioning_Version1_Transaction{Id: sl.Int(5)})
+	if len(transactions) != 1 || transactions[0]["active"] != false {
+		t.Errorf("Expected the inactive last transaction, got %v", transactions)
+	}
+	if transactions := flattenComputeTransactions(nil, datatypes.Provisioning_Version1_Transaction{}); len(transactions) != 0 {
+		t.Errorf("Expected no transactions, got %v", transactions)
+	}
+}
+
+func testAccCheckIBMComputeTransactionsDataSourceConfig(hostname string) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_vm_instance" "txvm" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "dal06"
+    network_speed = 10
+    hourly_billing = true
+    private_network_only = false
+    cores = 1
+    memory = 1024
+    disks = [25]
+    local_disk = false
+}
+
+data "ibm_compute_transactions" "tx" {
+    guest_id = "${ibm_compute_vm_instance.txvm.id}"
+}`, hostname)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
 	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
 )
 
 func dataSourceIBMComputeVmInstance() *schema.Resource {
@@ -54,6 +55,54 @@ func dataSourceIBMComputeVmInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"public_interface_id": &schema.Schema{
+				Description: "The ID of the public network component of the virtual guest",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"private_interface_id": &schema.Schema{
+				Description: "The ID of the private network component of the virtual guest",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"ipv4_address": &schema.Schema{
+				Description: "The public IPv4 address of the virtual guest",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ip_address_id": &schema.Schema{
+				Description: "The ID of the public IPv4 address of the virtual guest",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"ipv4_address_private": &schema.Schema{
+				Description: "The private IPv4 address of the virtual guest",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ip_address_id_private": &schema.Schema{
+				Description: "The ID of the private IPv4 address of the virtual guest",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"public_vlan_id": &schema.Schema{
+				Description: "The ID of the public VLAN of the virtual guest",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"private_vlan_id": &schema.Schema{
+				Description: "The ID of the private VLAN of the virtual guest",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
 			"most_recent": &schema.Schema{
 				Description: "If true and multiple entries are found, the most recently created virtual guest is used. " +
 					"If false, an error is returned",
@@ -73,11 +122,14 @@ func dataSourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}
 	domain := d.Get("domain").(string)
 	mostRecent := d.Get("most_recent").(bool)
 
-	vgs, err := service.
+	vgs, err := getAccountVirtualGuests(service.
 		Filter(filter.Build(filter.Path("virtualGuests.hostname").Eq(hostname),
 			filter.Path("virtualGuests.domain").Eq(domain))).Mask(
-		"hostname,domain,startCpus,datacenter[id,name,longName],statusId,status,id,powerState,lastKnownPowerState,createDate",
-	).GetVirtualGuests()
+		"hostname,domain,startCpus,datacenter[id,name,longName],statusId,status,id,powerState,lastKnownPowerState,createDate,"+
+			"primaryIpAddress,primaryBackendIpAddress,"+
+			"primaryNetworkComponent[id,networkVlan[id],primaryIpAddressRecord[guestNetworkComponentBinding[ipAddressId]]],"+
+			"primaryBackendNetworkComponent[id,networkVlan[id],primaryIpAddressRecord[guestNetworkComponentBinding[ipAddressId]]]",
+	))
 
 	if err != nil {
 		return fmt.Errorf("Error retrieving virtual guest details for host %s: %s", hostname, err)
@@ -118,6 +170,15 @@ func dataSourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}
 		d.Set("last_known_power_state", vg.LastKnownPowerState.KeyName)
 	}
 
+	d.Set("ipv4_address", sl.Get(vg.PrimaryIpAddress, ""))
+	d.Set("ipv4_address_private", sl.Get(vg.PrimaryBackendIpAddress, ""))
+	d.Set("public_interface_id", sl.Grab(vg, "PrimaryNetworkComponent.Id", 0))
+	d.Set("private_interface_id", sl.Grab(vg, "PrimaryBackendNetworkComponent.Id", 0))
+	d.Set("public_vlan_id", sl.Grab(vg, "PrimaryNetworkComponent.NetworkVlan.Id", 0))
+	d.Set("private_vlan_id", sl.Grab(vg, "PrimaryBackendNetworkComponent.NetworkVlan.Id", 0))
+	d.Set("ip_address_id", sl.Grab(vg, "PrimaryNetworkComponent.PrimaryIpAddressRecord.GuestNetworkComponentBinding.IpAddressId", 0))
+	d.Set("ip_address_id_private", sl.Grab(vg, "PrimaryBackendNetworkComponent.PrimaryIpAddressRecord.GuestNetworkComponentBinding.IpAddressId", 0))
+
 	return nil
 }
 
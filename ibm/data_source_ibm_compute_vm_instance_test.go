@@ -21,6 +21,12 @@ func TestAccIBMComputeVmInstanceDataSource_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.ibm_compute_vm_instance.tf-vg-ds-acc-test", "power_state", "RUNNING"),
 					resource.TestCheckResourceAttr("data.ibm_compute_vm_instance.tf-vg-ds-acc-test", "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair("data.ibm_compute_vm_instance.tf-vg-ds-acc-test", "ipv4_address",
+						"ibm_compute_vm_instance.tf-vg-acc-test", "ipv4_address"),
+					resource.TestCheckResourceAttrPair("data.ibm_compute_vm_instance.tf-vg-ds-acc-test", "private_vlan_id",
+						"ibm_compute_vm_instance.tf-vg-acc-test", "private_vlan_id"),
+					resource.TestCheckResourceAttrPair("data.ibm_compute_vm_instance.tf-vg-ds-acc-test", "ip_address_id_private",
+						"ibm_compute_vm_instance.tf-vg-acc-test", "ip_address_id_private"),
 				),
 			},
 		},
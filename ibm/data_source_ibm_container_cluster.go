@@ -65,12 +65,18 @@ func dataSourceIBMContainerCluster() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"region": {
+				Description:  "The region of the container service, which defaults to the region of the provider",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateContainerRegion,
+			},
 		},
 	}
 }
 
 func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return err
 	}
@@ -32,6 +32,12 @@ func dataSourceIBMContainerClusterConfig() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"region": {
+				Description:  "The region of the container service, which defaults to the region of the provider",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateContainerRegion,
+			},
 			"cluster_name_id": {
 				Description: "The name/id of the cluster",
 				Type:        schema.TypeString,
@@ -65,7 +71,7 @@ func dataSourceIBMContainerClusterConfig() *schema.Resource {
 }
 
 func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interface{}) error {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return err
 	}
@@ -34,10 +34,9 @@ func dataSourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error
 
 	name := d.Get("name").(string)
 
-	names, err := service.
+	names, err := getAccountDomains(service.
 		Filter(filter.Build(filter.Path("domains.name").Eq(name))).
-		Mask("id,name").
-		GetDomains()
+		Mask("id,name"))
 
 	if err != nil {
 		return fmt.Errorf("Error retrieving domain: %s", err)
@@ -0,0 +1,113 @@
+package ibm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func dataSourceIBMDNSDomainRegistration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMDNSDomainRegistrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Description: "A domain registration's internal identifier",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"name": &schema.Schema{
+				Description: "The name of the registered domain",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"name_servers": &schema.Schema{
+				Description: "The name servers of the registered domain",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"status": &schema.Schema{
+				Description: "The registration status of the domain",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"expire_date": &schema.Schema{
+				Description: "The date on which the registration of the domain expires",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"locked": &schema.Schema{
+				Description: "Whether the domain is locked against transfers",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMDNSDomainRegistrationRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	service := services.GetAccountService(sess)
+
+	name := d.Get("name").(string)
+
+	registrations, err := service.
+		Filter(filter.Build(filter.Path("domainRegistrations.name").Eq(name))).
+		Mask("id,name,expireDate,lockedFlag,domainRegistrationStatus[name]").
+		GetDomainRegistrations()
+
+	if err != nil {
+		return fmt.Errorf("Error retrieving domain registration: %s", err)
+	}
+
+	if len(registrations) == 0 {
+		return fmt.Errorf("No domain registration found with name [%s]", name)
+	}
+
+	registration := registrations[0]
+	nameServers, err := getDomainRegistrationNameServers(sess, *registration.Id)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the name servers of domain registration %d: %s", *registration.Id, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", *registration.Id))
+	d.Set("name_servers", nameServers)
+	d.Set("status", sl.Grab(registration, "DomainRegistrationStatus.Name", ""))
+	d.Set("locked", sl.Get(registration.LockedFlag, 0).(int) == 1)
+	if registration.ExpireDate != nil {
+		d.Set("expire_date", registration.ExpireDate.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func getDomainRegistrationNameServers(sess *session.Session, registrationID int) ([]string, error) {
+	containers, err := services.GetDnsDomainRegistrationService(sess).Id(registrationID).GetDomainNameservers()
+	if err != nil {
+		return nil, err
+	}
+	return flattenDomainRegistrationNameServers(containers), nil
+}
+
+func flattenDomainRegistrationNameServers(containers []datatypes.Container_Dns_Domain_Registration_Nameserver) []string {
+	nameServers := []string{}
+	for _, container := range containers {
+		for _, nameServer := range container.Nameservers {
+			if nameServer.Name != nil {
+				nameServers = append(nameServers, *nameServer.Name)
+			}
+		}
+	}
+	return nameServers
+}
@@ -0,0 +1,54 @@
+package ibm
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMDNSDomainRegistrationDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccCheckIBMDNSDomainRegistrationDataSourceConfig_basic, dnsRegistrationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_dns_domain_registration.registration", "name", dnsRegistrationName),
+					resource.TestMatchResourceAttr("data.ibm_dns_domain_registration.registration", "id", regexp.MustCompile("^[0-9]+$")),
+					resource.TestCheckResourceAttrSet("data.ibm_dns_domain_registration.registration", "name_servers.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenDomainRegistrationNameServers(t *testing.T) {
+	containers := []datatypes.Container_Dns_Domain_Registration_Nameserver{
+		{
+			Nameservers: []datatypes.Container_Dns_Domain_Registration_Nameserver_List{
+				{Name: sl.String("ns1.softlayer.com")},
+				{Name: sl.String("ns2.softlayer.com"), Ipv4Address: sl.String("10.0.0.1")},
+				{Ipv4Address: sl.String("10.0.0.2")},
+			},
+		},
+	}
+
+	expected := []string{"ns1.softlayer.com", "ns2.softlayer.com"}
+	nameServers := flattenDomainRegistrationNameServers(containers)
+	if !reflect.DeepEqual(nameServers, expected) {
+		t.Fatalf("Expected %v, got %v", expected, nameServers)
+	}
+}
+
+// The datasource to apply
+const testAccCheckIBMDNSDomainRegistrationDataSourceConfig_basic = `
+data "ibm_dns_domain_registration" "registration" {
+    name = "%s"
+}
+`
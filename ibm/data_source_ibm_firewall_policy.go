@@ -0,0 +1,108 @@
+package ibm
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/services"
+)
+
+func dataSourceIBMFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMFirewallPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"firewall_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"src_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"src_ip_cidr": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"dst_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dst_ip_cidr": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"dst_port_range_start": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"dst_port_range_end": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"rules_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"rules_csv": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	fwId := d.Get("firewall_id").(int)
+
+	fw, err := services.GetNetworkVlanFirewallService(sess).
+		Id(fwId).
+		Mask("rules").
+		GetObject()
+	if err != nil {
+		return fmt.Errorf("Error retrieving firewall rules: %s", err)
+	}
+
+	rules := flattenFirewallRules(fw.Rules)
+
+	rulesJSON, err := formatFirewallRulesJSON(rules)
+	if err != nil {
+		return fmt.Errorf("Error exporting firewall rules: %s", err)
+	}
+	rulesCSV, err := formatFirewallRulesCSV(rules)
+	if err != nil {
+		return fmt.Errorf("Error exporting firewall rules: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(fwId))
+	d.Set("rules", rules)
+	d.Set("rules_json", rulesJSON)
+	d.Set("rules_csv", rulesCSV)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package ibm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMFirewallPolicyDataSource_Basic(t *testing.T) {
+	hostname := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMFirewallPolicyDataSourceConfig(hostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ibm_firewall_policy.exported", "rules.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_firewall_policy.exported", "rules.1.notes", "Allow SSH"),
+					resource.TestMatchResourceAttr(
+						"data.ibm_firewall_policy.exported", "rules_csv", regexp.MustCompile("^action,src_ip_address,")),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_firewall_policy.exported", "rules_json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMFirewallPolicyDataSourceConfig(hostname string) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_vm_instance" "fwvm3" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "sjc01"
+    network_speed = 10
+    hourly_billing = true
+    private_network_only = false
+    cores = 1
+    memory = 1024
+    disks = [25]
+    local_disk = false
+}
+
+resource "ibm_firewall" "accfw3" {
+  ha_enabled = false
+  public_vlan_id = "${ibm_compute_vm_instance.fwvm3.public_vlan_id}"
+}
+
+resource "ibm_firewall_policy" "rules" {
+  firewall_id = "${ibm_firewall.accfw3.id}"
+  rules_file = "test-fixtures/firewall_rules.csv"
+}
+
+data "ibm_firewall_policy" "exported" {
+  firewall_id = "${ibm_firewall_policy.rules.firewall_id}"
+}
+`, hostname)
+}
@@ -0,0 +1,148 @@
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const (
+	firewallTypeDedicated = "dedicated"
+	firewallTypeShared    = "shared"
+
+	sharedFirewallMask = "id,firewallServiceComponent[id,ruleCount,status]"
+)
+
+func dataSourceIBMHardwareFirewallShared() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMHardwareFirewallSharedRead,
+
+		Schema: map[string]*schema.Schema{
+			"vlan_id": {
+				Description:   "The ID of the VLAN protected by a dedicated firewall",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"hostname", "domain"},
+			},
+
+			"hostname": {
+				Description:   "The hostname of the virtual guest or bare metal server protected by a shared firewall",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"vlan_id"},
+			},
+
+			"domain": {
+				Description:   "The domain of the virtual guest or bare metal server protected by a shared firewall",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"vlan_id"},
+			},
+
+			"firewall_type": {
+				Description: "The type of the firewall, either dedicated or shared",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"rule_count": {
+				Description: "The number of rules configured on the firewall",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"status": {
+				Description: "The status of the shared firewall",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMHardwareFirewallSharedRead(d *schema.ResourceData, meta interface{}) error {
+	if vlanId, ok := d.GetOk("vlan_id"); ok {
+		return readDedicatedFirewallByVlan(d, meta, vlanId.(int))
+	}
+
+	hostname := d.Get("hostname").(string)
+	if hostname == "" {
+		return fmt.Errorf("One of vlan_id or hostname must be set")
+	}
+	return readSharedFirewallByHostname(d, meta, hostname, d.Get("domain").(string))
+}
+
+func readDedicatedFirewallByVlan(d *schema.ResourceData, meta interface{}, vlanId int) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	vlan, err := services.GetNetworkVlanService(sess).
+		Id(vlanId).
+		Mask("id,networkVlanFirewall[id,ruleCount]").
+		GetObject()
+	if err != nil {
+		return fmt.Errorf("Error retrieving firewall of vlan %d: %s", vlanId, err)
+	}
+	if vlan.NetworkVlanFirewall == nil || vlan.NetworkVlanFirewall.Id == nil {
+		return fmt.Errorf("No dedicated firewall was found for vlan %d", vlanId)
+	}
+
+	d.SetId(fmt.Sprintf("%d", *vlan.NetworkVlanFirewall.Id))
+	d.Set("firewall_type", firewallTypeDedicated)
+	d.Set("rule_count", int(sl.Get(vlan.NetworkVlanFirewall.RuleCount, uint(0)).(uint)))
+
+	return nil
+}
+
+func readSharedFirewallByHostname(d *schema.ResourceData, meta interface{}, hostname, domain string) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	service := services.GetAccountService(sess)
+
+	var firewalls []*datatypes.Network_Component_Firewall
+
+	vgFilters := []filter.Filter{filter.Path("virtualGuests.hostname").Eq(hostname)}
+	if domain != "" {
+		vgFilters = append(vgFilters, filter.Path("virtualGuests.domain").Eq(domain))
+	}
+	vgs, err := getAccountVirtualGuests(service.Filter(filter.Build(vgFilters...)).Mask(sharedFirewallMask))
+	if err != nil {
+		return fmt.Errorf("Error retrieving virtual guests with hostname %s: %s", hostname, err)
+	}
+	for _, vg := range vgs {
+		if vg.FirewallServiceComponent != nil {
+			firewalls = append(firewalls, vg.FirewallServiceComponent)
+		}
+	}
+
+	hwFilters := []filter.Filter{filter.Path("hardware.hostname").Eq(hostname)}
+	if domain != "" {
+		hwFilters = append(hwFilters, filter.Path("hardware.domain").Eq(domain))
+	}
+	hws, err := getAccountHardware(service.Filter(filter.Build(hwFilters...)).Mask(sharedFirewallMask))
+	if err != nil {
+		return fmt.Errorf("Error retrieving hardware with hostname %s: %s", hostname, err)
+	}
+	for _, hw := range hws {
+		if hw.FirewallServiceComponent != nil {
+			firewalls = append(firewalls, hw.FirewallServiceComponent)
+		}
+	}
+
+	if len(firewalls) == 0 {
+		return fmt.Errorf("No shared firewall was found for hostname %s", hostname)
+	}
+	if len(firewalls) > 1 {
+		return fmt.Errorf("More than one shared firewall was found for hostname %s. Set domain to narrow the search", hostname)
+	}
+
+	fw := firewalls[0]
+	d.SetId(fmt.Sprintf("%d", *fw.Id))
+	d.Set("firewall_type", firewallTypeShared)
+	d.Set("rule_count", int(sl.Get(fw.RuleCount, uint(0)).(uint)))
+	d.Set("status", sl.Get(fw.Status, "").(string))
+
+	return nil
+}
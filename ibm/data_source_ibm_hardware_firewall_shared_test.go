@@ -0,0 +1,58 @@
+package ibm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMHardwareFirewallSharedDataSource_Basic(t *testing.T) {
+	hostname := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMHardwareFirewallSharedDataSourceConfig(hostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_hardware_firewall_shared.by_vlan", "id", "ibm_firewall.accfw4", "id"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_hardware_firewall_shared.by_vlan", "firewall_type", "dedicated"),
+					resource.TestMatchResourceAttr(
+						"data.ibm_hardware_firewall_shared.by_vlan", "rule_count", regexp.MustCompile("^[0-9]+$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMHardwareFirewallSharedDataSourceConfig(hostname string) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_vm_instance" "fwvm4" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "sjc01"
+    network_speed = 10
+    hourly_billing = true
+    private_network_only = false
+    cores = 1
+    memory = 1024
+    disks = [25]
+    local_disk = false
+}
+
+resource "ibm_firewall" "accfw4" {
+  ha_enabled = false
+  public_vlan_id = "${ibm_compute_vm_instance.fwvm4.public_vlan_id}"
+}
+
+data "ibm_hardware_firewall_shared" "by_vlan" {
+  vlan_id = "${ibm_firewall.accfw4.public_vlan_id}"
+}
+`, hostname)
+}
@@ -0,0 +1,139 @@
+package ibm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM-Bluemix/bluemix-go/authentication"
+	"github.com/IBM-Bluemix/bluemix-go/http"
+	"github.com/IBM-Bluemix/bluemix-go/rest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// iamTokenClaims are the claims of an IAM access token used by the provider. The claims which are
+// not in the token, like the email of a service ID, are left empty.
+type iamTokenClaims struct {
+	Subject    string `json:"sub"`
+	IAMID      string `json:"iam_id"`
+	Expiration int64  `json:"exp"`
+	Account    struct {
+		BSS string `json:"bss"`
+	} `json:"account"`
+}
+
+func dataSourceIBMIAMToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMTokenRead,
+
+		Schema: map[string]*schema.Schema{
+			"iam_access_token": {
+				Description: "The IAM access token of the configured credentials",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+
+			"subject": {
+				Description: "The subject of the IAM access token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"iam_id": {
+				Description: "The IAM ID of the user or service ID of the credentials",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"account_id": {
+				Description: "The ID of the account of the credentials",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"expiration": {
+				Description: "The expiration time of the IAM access token, in RFC 3339 format",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMTokenRead(d *schema.ResourceData, meta interface{}) error {
+	bmxSess, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	// A new token is requested on every read so that the credentials are always validated. It is
+	// requested with a copy of the configuration to leave the tokens of the clients untouched.
+	config := bmxSess.Copy().Config
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.NewHTTPClient(config)
+	}
+	iam, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		DefaultHeader: gohttp.Header{
+			"User-Agent": []string{http.UserAgent()},
+		},
+		HTTPClient: config.HTTPClient,
+	})
+	if err != nil {
+		return fmt.Errorf("Error configuring the IAM authentication: %s", err)
+	}
+	err = authentication.PopulateTokens(iam, config)
+	if err != nil {
+		return fmt.Errorf("Error validating the credentials: %s", err)
+	}
+
+	token := iamAccessToken(config.IAMAccessToken)
+	claims, err := parseIAMTokenClaims(token)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(claims.Subject)
+	d.Set("iam_access_token", token)
+	d.Set("subject", claims.Subject)
+	d.Set("iam_id", claims.IAMID)
+	d.Set("account_id", claims.Account.BSS)
+	if claims.Expiration != 0 {
+		d.Set("expiration", time.Unix(claims.Expiration, 0).UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// iamAccessToken returns the IAM access token without the token type of the authorization header
+func iamAccessToken(header string) string {
+	fields := strings.Fields(header)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
+
+// parseIAMTokenClaims returns the claims of the payload of the IAM access token. The signature of
+// the token is not verified, since the token is received from the IAM endpoint.
+func parseIAMTokenClaims(token string) (*iamTokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Error parsing the IAM access token: not a JSON web token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding the IAM access token: %s", err)
+	}
+
+	claims := &iamTokenClaims{}
+	err = json.Unmarshal(payload, claims)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the claims of the IAM access token: %s", err)
+	}
+	return claims, nil
+}
@@ -0,0 +1,50 @@
+package ibm
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMIAMTokenDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTokenDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_token.testacc_ds_iam_token", "iam_access_token"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_token.testacc_ds_iam_token", "subject"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_token.testacc_ds_iam_token", "account_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_token.testacc_ds_iam_token", "expiration"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseIAMTokenClaims(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(
+		`{"iam_id":"iam-ServiceId-1234","sub":"ServiceId-1234","account":{"bss":"abcd"},"exp":1500000000}`))
+	claims, err := parseIAMTokenClaims(iamAccessToken("Bearer header." + payload + ".signature"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if claims.Subject != "ServiceId-1234" || claims.IAMID != "iam-ServiceId-1234" {
+		t.Errorf("Unexpected subject or IAM ID: %+v", claims)
+	}
+	if claims.Account.BSS != "abcd" || claims.Expiration != 1500000000 {
+		t.Errorf("Unexpected account or expiration: %+v", claims)
+	}
+
+	_, err = parseIAMTokenClaims("not-a-token")
+	if err == nil {
+		t.Errorf("Expected an error for a token which is not a JSON web token")
+	}
+}
+
+const testAccCheckIBMIAMTokenDataSourceConfig = `
+data "ibm_iam_token" "testacc_ds_iam_token" {}
+`
@@ -22,6 +22,65 @@ func dataSourceIBMIAMUserPolicy() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"service_name": {
+				Description: "Only list the role assignments on this service",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role": {
+				Description: "Only list the role assignments of this role",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role_assignments": {
+				Description: "The roles assigned to the user by the policies, one per role and resource",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ibm_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_instance": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"space_guid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organization_guid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"policies": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -105,6 +164,9 @@ func dataSourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) er
 	}
 	policies := userPolicies.Policies
 	accountPolicyListMap := make([]map[string]interface{}, 0, len(policies))
+	roleAssignments := make([]map[string]interface{}, 0, len(policies))
+	serviceName := d.Get("service_name").(string)
+	role := d.Get("role").(string)
 	for _, policy := range policies {
 		roles := flattenIAMPolicyRoles(policy.Roles)
 		resources, err := flattenIAMPolicyResource(policy.Resources, iamClient)
@@ -117,9 +179,47 @@ func dataSourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) er
 			"resources": resources,
 		}
 		accountPolicyListMap = append(accountPolicyListMap, l)
+
+		for _, assignment := range flattenIAMRoleAssignments(ibmID, policy.ID, roles, resources) {
+			if serviceName != "" && assignment["service_name"] != serviceName {
+				continue
+			}
+			if role != "" && assignment["role"] != role {
+				continue
+			}
+			roleAssignments = append(roleAssignments, assignment)
+		}
 	}
 	//Id is composed of user in a particular account
 	d.SetId(fmt.Sprintf("%s/%s", ibmID, accountGUID))
 	d.Set("policies", accountPolicyListMap)
+	d.Set("role_assignments", roleAssignments)
 	return nil
 }
+
+// flattenIAMRoleAssignments returns one role assignment for every role and resource of a policy,
+// so that the assignments of a user can be filtered and counted without walking nested blocks
+func flattenIAMRoleAssignments(ibmID, policyID string, roles, resources []map[string]interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(roles)*len(resources))
+	for _, role := range roles {
+		for _, resource := range resources {
+			serviceInstance := ""
+			if instances, ok := resource["service_instance"].([]string); ok && len(instances) > 0 {
+				serviceInstance = instances[0]
+			}
+			result = append(result, map[string]interface{}{
+				"policy_id":         policyID,
+				"ibm_id":            ibmID,
+				"role":              role["name"],
+				"service_name":      resource["service_name"],
+				"service_instance":  serviceInstance,
+				"region":            resource["region"],
+				"resource_type":     resource["resource_type"],
+				"resource":          resource["resource"],
+				"space_guid":        resource["space_guid"],
+				"organization_guid": resource["organization_guid"],
+			})
+		}
+	}
+	return result
+}
@@ -69,3 +69,28 @@ data "ibm_iam_user_policy" "testacc_iam_policies" {
 `, cfOrganization, IAMUser)
 
 }
+
+func TestFlattenIAMRoleAssignments(t *testing.T) {
+	roles := []map[string]interface{}{
+		{"name": "viewer"},
+		{"name": "editor"},
+	}
+	resources := []map[string]interface{}{
+		{"service_name": "IBM Bluemix Container Service", "service_instance": []string{"instance-1"}},
+		{"service_name": "All Identity and Access enabled services"},
+	}
+
+	result := flattenIAMRoleAssignments("user@example.com", "policy-1", roles, resources)
+	if len(result) != 4 {
+		t.Fatalf("Expected 4 role assignments, got %d", len(result))
+	}
+	if result[0]["role"] != "viewer" || result[0]["service_instance"] != "instance-1" {
+		t.Errorf("Unexpected first role assignment: %v", result[0])
+	}
+	if result[3]["role"] != "editor" || result[3]["service_instance"] != "" {
+		t.Errorf("Unexpected last role assignment: %v", result[3])
+	}
+	if result[3]["policy_id"] != "policy-1" || result[3]["ibm_id"] != "user@example.com" {
+		t.Errorf("Expected the role assignment to reference the policy and user: %v", result[3])
+	}
+}
@@ -20,6 +20,13 @@ func dataSourceIBMNetworkVlan() *schema.Resource {
 				Computed: true,
 			},
 
+			"vlan_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "number", "router_hostname"},
+			},
+
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -57,7 +64,27 @@ func dataSourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) erro
 	var vlan *datatypes.Network_Vlan
 	var err error
 
-	if number != 0 && routerHostname != "" {
+	if vlanId, ok := d.GetOk("vlan_id"); ok {
+		// Got vlan ID, such as the ID of a vlan assigned automatically to a virtual guest,
+		// get vlan, and compute name, router hostname and vlan number
+		result, err := services.GetNetworkVlanService(sess).
+			Id(vlanId.(int)).
+			Mask("id,vlanNumber,name,primaryRouter[hostname],primarySubnets[networkIdentifier,cidr]").
+			GetObject()
+		if err != nil {
+			return fmt.Errorf("Error retrieving VLAN %d: %s", vlanId.(int), err)
+		}
+
+		vlan = &result
+		d.SetId(fmt.Sprintf("%d", *vlan.Id))
+		d.Set("number", *vlan.VlanNumber)
+		if vlan.Name != nil {
+			d.Set("name", *vlan.Name)
+		}
+		if vlan.PrimaryRouter != nil && vlan.PrimaryRouter.Hostname != nil {
+			d.Set("router_hostname", *vlan.PrimaryRouter.Hostname)
+		}
+	} else if number != 0 && routerHostname != "" {
 		// Got vlan number and router, get vlan, and compute name
 		vlan, err = getVlan(number, routerHostname, meta)
 		if err != nil {
@@ -70,10 +97,9 @@ func dataSourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	} else if name != "" {
 		// Got name, get vlan, and compute router hostname and vlan number
-		networkVlans, err := service.
+		networkVlans, err := getAccountNetworkVlans(service.
 			Mask("id,vlanNumber,name,primaryRouter[hostname],primarySubnets[networkIdentifier,cidr]").
-			Filter(filter.Path("networkVlans.name").Eq(name).Build()).
-			GetNetworkVlans()
+			Filter(filter.Path("networkVlans.name").Eq(name).Build()))
 		if err != nil {
 			return fmt.Errorf("Error obtaining VLAN id: %s", err)
 		} else if len(networkVlans) == 0 {
@@ -88,9 +114,11 @@ func dataSourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) erro
 			d.Set("router_hostname", *vlan.PrimaryRouter.Hostname)
 		}
 	} else {
-		return errors.New("Missing required properties. Need a VLAN name, or the VLAN's number and router hostname.")
+		return errors.New("Missing required properties. Need a VLAN ID, a VLAN name, or the VLAN's number and router hostname.")
 	}
 
+	d.Set("vlan_id", *vlan.Id)
+
 	// Get subnets in cidr format for display
 	if len(vlan.PrimarySubnets) > 0 {
 		subnets := make([]string, len(vlan.PrimarySubnets))
@@ -107,15 +135,14 @@ func dataSourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) erro
 func getVlan(vlanNumber int, primaryRouterHostname string, meta interface{}) (*datatypes.Network_Vlan, error) {
 	service := services.GetAccountService(meta.(ClientSession).SoftLayerSession())
 
-	networkVlans, err := service.
+	networkVlans, err := getAccountNetworkVlans(service.
 		Mask("id,name,primarySubnets[networkIdentifier,cidr]").
 		Filter(
 			filter.Build(
 				filter.Path("networkVlans.primaryRouter.hostname").Eq(primaryRouterHostname),
 				filter.Path("networkVlans.vlanNumber").Eq(vlanNumber),
 			),
-		).
-		GetNetworkVlans()
+		))
 
 	if err != nil {
 		return &datatypes.Network_Vlan{}, fmt.Errorf("Error looking up Vlan: %s", err)
@@ -0,0 +1,84 @@
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/services"
+)
+
+func dataSourceIBMNetworkVlanDetails() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMNetworkVlanDetailsRead,
+
+		Schema: map[string]*schema.Schema{
+			"vlan_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"gateway": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"broadcast_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"netmask": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usable_ip_address_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceIBMNetworkVlanDetailsRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	vlanId := d.Get("vlan_id").(int)
+
+	vlan, err := services.GetNetworkVlanService(sess).
+		Id(vlanId).
+		Mask("id," + vlanSubnetsMask + ",tagReferences[id,tag[name]]").
+		GetObject()
+	if err != nil {
+		return fmt.Errorf("Error retrieving vlan: %s", err)
+	}
+
+	tags := make([]string, 0, len(vlan.TagReferences))
+	for _, tagRef := range vlan.TagReferences {
+		tags = append(tags, *tagRef.Tag.Name)
+	}
+
+	d.SetId(fmt.Sprintf("%d", *vlan.Id))
+	d.Set("subnets", flattenVlanSubnets(vlan.Subnets))
+	d.Set("tags", tags)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMNetworkVlanDetailsDataSource_Basic(t *testing.T) {
+
+	name := fmt.Sprintf("terraformuat_vlan_%s", acctest.RandString(2))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMNetworkVlanDetailsDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_network_vlan_details.tfacc_vlan", "subnets.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_network_vlan_details.tfacc_vlan", "subnets.0.subnet_type", "PRIMARY"),
+					resource.TestCheckResourceAttr("data.ibm_network_vlan_details.tfacc_vlan", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_network_vlan_details.tfacc_vlan", "tags.0", "collectd"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMNetworkVlanDetailsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ibm_network_vlan" "test_vlan_private" {
+    name            = "%s"
+    datacenter      = "dal06"
+    type            = "PRIVATE"
+    subnet_size     = 8
+    tags            = ["collectd"]
+}
+
+data "ibm_network_vlan_details" "tfacc_vlan" {
+    vlan_id = "${ibm_network_vlan.test_vlan_private.id}"
+}`, name)
+}
@@ -0,0 +1,150 @@
+package ibm
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const vlanFirewallsMask = "id,vlanNumber,name,networkSpace,dedicatedFirewallFlag,highAvailabilityFirewallFlag," +
+	"primaryRouter[datacenter[name]],networkVlanFirewall[id,firewallType,primaryIpAddress,ruleCount]"
+
+func dataSourceIBMNetworkVlanFirewalls() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMNetworkVlanFirewallsRead,
+
+		Schema: map[string]*schema.Schema{
+			"datacenter": {
+				Description: "The datacenter in which to look for the firewalls, all the datacenters when not set",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"firewalls": {
+				Description: "The dedicated firewalls of the account",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"firewall_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"rule_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vlan_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vlan_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vlan_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_space": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datacenter": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"unprotected_public_vlan_ids": {
+				Description: "The IDs of the public VLANs which are not protected by a dedicated firewall",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceIBMNetworkVlanFirewallsRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	service := services.GetAccountService(sess).Mask(vlanFirewallsMask)
+
+	dc := d.Get("datacenter").(string)
+	if dc != "" {
+		service = service.Filter(filter.Path("networkVlans.primaryRouter.datacenter.name").Eq(dc).Build())
+	}
+
+	vlans, err := getAccountNetworkVlans(service)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the vlans of the account: %s", err)
+	}
+
+	firewalls, unprotected := flattenVlanFirewalls(vlans)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("firewalls", firewalls)
+	d.Set("unprotected_public_vlan_ids", unprotected)
+
+	return nil
+}
+
+// flattenVlanFirewalls returns the dedicated firewalls of the VLANs and the IDs of the public VLANs
+// without one, both in the order of the VLAN IDs
+func flattenVlanFirewalls(vlans []datatypes.Network_Vlan) ([]map[string]interface{}, []int) {
+	sorted := make([]datatypes.Network_Vlan, 0, len(vlans))
+	for _, vlan := range vlans {
+		if vlan.Id != nil {
+			sorted = append(sorted, vlan)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return *sorted[i].Id < *sorted[j].Id
+	})
+
+	firewalls := make([]map[string]interface{}, 0)
+	unprotected := make([]int, 0)
+	for _, vlan := range sorted {
+		networkSpace := sl.Get(vlan.NetworkSpace, "").(string)
+		if sl.Get(vlan.DedicatedFirewallFlag, 0).(int) != 1 || vlan.NetworkVlanFirewall == nil {
+			if networkSpace == "PUBLIC" {
+				unprotected = append(unprotected, *vlan.Id)
+			}
+			continue
+		}
+		fw := vlan.NetworkVlanFirewall
+		firewalls = append(firewalls, map[string]interface{}{
+			"id":                 sl.Get(fw.Id, 0),
+			"firewall_type":      sl.Get(fw.FirewallType, ""),
+			"primary_ip_address": sl.Get(fw.PrimaryIpAddress, ""),
+			"ha_enabled":         sl.Get(vlan.HighAvailabilityFirewallFlag, false),
+			"rule_count":         int(sl.Get(fw.RuleCount, uint(0)).(uint)),
+			"vlan_id":            *vlan.Id,
+			"vlan_number":        sl.Get(vlan.VlanNumber, 0),
+			"vlan_name":          sl.Get(vlan.Name, ""),
+			"network_space":      networkSpace,
+			"datacenter":         sl.Grab(vlan, "PrimaryRouter.Datacenter.Name", ""),
+		})
+	}
+	return firewalls, unprotected
+}
@@ -0,0 +1,75 @@
+package ibm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMNetworkVlanFirewallsDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMNetworkVlanFirewallsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_network_vlan_firewalls.dal06", "firewalls.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_network_vlan_firewalls.dal06", "unprotected_public_vlan_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenVlanFirewalls(t *testing.T) {
+	firewalled := testVlan(30, "PUBLIC", "fcr01a.dal06", true)
+	firewalled.Name = sl.String("web")
+	firewalled.HighAvailabilityFirewallFlag = sl.Bool(true)
+	firewalled.PrimaryRouter.Datacenter = &datatypes.Location{Name: sl.String("dal06")}
+	firewalled.NetworkVlanFirewall = &datatypes.Network_Vlan_Firewall{
+		Id:               sl.Int(300),
+		FirewallType:     sl.String("HARDWARE_FIREWALL_HIGH_AVAILABILITY"),
+		PrimaryIpAddress: sl.String("10.0.0.1"),
+		RuleCount:        sl.Uint(4),
+	}
+
+	vlans := []datatypes.Network_Vlan{
+		firewalled,
+		testVlan(20, "PUBLIC", "fcr01a.dal06", false),
+		testVlan(21, "PRIVATE", "bcr01a.dal06", false),
+		testVlan(10, "PUBLIC", "fcr02a.dal06", false),
+	}
+
+	firewalls, unprotected := flattenVlanFirewalls(vlans)
+
+	expected := []map[string]interface{}{
+		{
+			"id":                 300,
+			"firewall_type":      "HARDWARE_FIREWALL_HIGH_AVAILABILITY",
+			"primary_ip_address": "10.0.0.1",
+			"ha_enabled":         true,
+			"rule_count":         4,
+			"vlan_id":            30,
+			"vlan_number":        30,
+			"vlan_name":          "web",
+			"network_space":      "PUBLIC",
+			"datacenter":         "dal06",
+		},
+	}
+	if !reflect.DeepEqual(firewalls, expected) {
+		t.Errorf("Expected firewalls %v, got %v", expected, firewalls)
+	}
+	if !reflect.DeepEqual(unprotected, []int{10, 20}) {
+		t.Errorf("Expected unprotected public vlans [10 20], got %v", unprotected)
+	}
+}
+
+const testAccCheckIBMNetworkVlanFirewallsDataSourceConfig = `
+data "ibm_network_vlan_firewalls" "dal06" {
+    datacenter = "dal06"
+}
+`
@@ -0,0 +1,243 @@
+package ibm
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const vlanPlacementMask = "id,vlanNumber,networkSpace,dedicatedFirewallFlag,primarySubnetId,primaryRouter[hostname]"
+
+// routerPodRegexp matches the hostname of a router, such as fcr01a.dal06 or bcr01a.dal06. The public
+// (fcr) and private (bcr) routers of a pod share the same number and datacenter.
+var routerPodRegexp = regexp.MustCompile(`^[a-z]+(\d+[a-z]?\.[a-z0-9]+)$`)
+
+// vlanPlacement is the set of requirements on the VLANs returned by the ibm_network_vlan_placement
+// data source
+type vlanPlacement struct {
+	privateNetworkOnly bool
+	firewall           bool
+	pod                string
+	excludeVlanIds     map[int]bool
+}
+
+func dataSourceIBMNetworkVlanPlacement() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMNetworkVlanPlacementRead,
+
+		Schema: map[string]*schema.Schema{
+			"datacenter": {
+				Description: "The datacenter in which to look for the VLANs",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"private_network_only": {
+				Description: "Whether only a private VLAN is needed",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"firewall": {
+				Description: "Whether the VLANs must be protected by a dedicated firewall",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"router_hostname": {
+				Description: "The hostname of a router of the pod in which to look for the VLANs",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"exclude_vlan_ids": {
+				Description: "The IDs of the VLANs which must not be returned",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Set:         HashInt,
+			},
+
+			"pod": {
+				Description: "The pod of the VLANs",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"public_vlan_id": {
+				Description: "The ID of the public VLAN",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"public_vlan_number": {
+				Description: "The number of the public VLAN",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"public_router_hostname": {
+				Description: "The hostname of the router of the public VLAN",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"private_vlan_id": {
+				Description: "The ID of the private VLAN",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"private_vlan_number": {
+				Description: "The number of the private VLAN",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"private_router_hostname": {
+				Description: "The hostname of the router of the private VLAN",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMNetworkVlanPlacementRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	dc := d.Get("datacenter").(string)
+
+	placement := vlanPlacement{
+		privateNetworkOnly: d.Get("private_network_only").(bool),
+		firewall:           d.Get("firewall").(bool),
+		excludeVlanIds:     make(map[int]bool),
+	}
+	if routerHostname, ok := d.GetOk("router_hostname"); ok {
+		pod, err := routerPod(routerHostname.(string))
+		if err != nil {
+			return err
+		}
+		placement.pod = pod
+	}
+	for _, id := range d.Get("exclude_vlan_ids").(*schema.Set).List() {
+		placement.excludeVlanIds[id.(int)] = true
+	}
+
+	vlans, err := getAccountNetworkVlans(services.GetAccountService(sess).
+		Filter(filter.Path("networkVlans.primaryRouter.datacenter.name").Eq(dc).Build()).
+		Mask(vlanPlacementMask))
+	if err != nil {
+		return fmt.Errorf("Error retrieving the vlans of datacenter %s: %s", dc, err)
+	}
+
+	public, private, err := selectVlanPlacement(vlans, placement)
+	if err != nil {
+		return fmt.Errorf("Error selecting the vlans of datacenter %s: %s", dc, err)
+	}
+
+	pod, _ := routerPod(*private.PrimaryRouter.Hostname)
+	d.Set("pod", pod)
+	d.Set("private_vlan_id", *private.Id)
+	d.Set("private_vlan_number", sl.Get(private.VlanNumber, 0))
+	d.Set("private_router_hostname", *private.PrimaryRouter.Hostname)
+	if public == nil {
+		d.SetId(fmt.Sprintf("%d", *private.Id))
+	} else {
+		d.SetId(fmt.Sprintf("%d:%d", *public.Id, *private.Id))
+		d.Set("public_vlan_id", *public.Id)
+		d.Set("public_vlan_number", sl.Get(public.VlanNumber, 0))
+		d.Set("public_router_hostname", *public.PrimaryRouter.Hostname)
+	}
+
+	return nil
+}
+
+// selectVlanPlacement returns a public and a private VLAN of the same pod which meet the
+// placement requirements. The public VLAN is nil when only a private VLAN is needed. The VLANs
+// are selected in the order of their pod and ID, so that the same pair is returned on every read.
+func selectVlanPlacement(vlans []datatypes.Network_Vlan, placement vlanPlacement) (*datatypes.Network_Vlan, *datatypes.Network_Vlan, error) {
+	publicVlans := make(map[string][]datatypes.Network_Vlan)
+	privateVlans := make(map[string][]datatypes.Network_Vlan)
+	pods := []string{}
+
+	for _, vlan := range vlans {
+		if vlan.Id == nil || placement.excludeVlanIds[*vlan.Id] {
+			continue
+		}
+		// VLANs without a primary subnet cannot host servers
+		if vlan.PrimarySubnetId == nil {
+			continue
+		}
+		pod, err := routerPod(sl.Grab(vlan, "PrimaryRouter.Hostname", "").(string))
+		if err != nil || (placement.pod != "" && pod != placement.pod) {
+			continue
+		}
+
+		firewalled := sl.Get(vlan.DedicatedFirewallFlag, 0).(int) == 1
+		switch sl.Get(vlan.NetworkSpace, "").(string) {
+		case "PUBLIC":
+			if placement.firewall && !firewalled {
+				continue
+			}
+			publicVlans[pod] = append(publicVlans[pod], vlan)
+		case "PRIVATE":
+			if placement.firewall && placement.privateNetworkOnly && !firewalled {
+				continue
+			}
+			privateVlans[pod] = append(privateVlans[pod], vlan)
+		default:
+			continue
+		}
+		if len(publicVlans[pod])+len(privateVlans[pod]) == 1 {
+			pods = append(pods, pod)
+		}
+	}
+
+	sort.Strings(pods)
+	for _, pod := range pods {
+		if len(privateVlans[pod]) == 0 {
+			continue
+		}
+		private := lowestVlan(privateVlans[pod])
+		if placement.privateNetworkOnly {
+			return nil, private, nil
+		}
+		if len(publicVlans[pod]) == 0 {
+			continue
+		}
+		return lowestVlan(publicVlans[pod]), private, nil
+	}
+
+	if placement.privateNetworkOnly {
+		return nil, nil, fmt.Errorf("No private vlan meets the requirements")
+	}
+	return nil, nil, fmt.Errorf("No pair of public and private vlans of the same pod meets the requirements")
+}
+
+func lowestVlan(vlans []datatypes.Network_Vlan) *datatypes.Network_Vlan {
+	lowest := vlans[0]
+	for _, vlan := range vlans[1:] {
+		if *vlan.Id < *lowest.Id {
+			lowest = vlan
+		}
+	}
+	return &lowest
+}
+
+// routerPod returns the pod of a router, such as 01a.dal06 for the routers fcr01a.dal06 and
+// bcr01a.dal06
+func routerPod(routerHostname string) (string, error) {
+	match := routerPodRegexp.FindStringSubmatch(routerHostname)
+	if match == nil {
+		return "", fmt.Errorf("Not a valid router hostname: %s", routerHostname)
+	}
+	return match[1], nil
+}
@@ -0,0 +1,109 @@
+package ibm
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMNetworkVlanPlacementDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMNetworkVlanPlacementDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_network_vlan_placement.pair", "public_vlan_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_network_vlan_placement.pair", "private_vlan_id"),
+					resource.TestMatchResourceAttr("data.ibm_network_vlan_placement.pair", "public_router_hostname", regexp.MustCompile(`\.dal06$`)),
+					resource.TestMatchResourceAttr("data.ibm_network_vlan_placement.pair", "private_router_hostname", regexp.MustCompile(`\.dal06$`)),
+				),
+			},
+		},
+	})
+}
+
+func testVlan(id int, space, router string, firewall bool) datatypes.Network_Vlan {
+	vlan := datatypes.Network_Vlan{
+		Id:              sl.Int(id),
+		VlanNumber:      sl.Int(id),
+		NetworkSpace:    sl.String(space),
+		PrimarySubnetId: sl.Int(id),
+		PrimaryRouter:   &datatypes.Hardware_Router{Hardware_Switch: datatypes.Hardware_Switch{Hardware: datatypes.Hardware{Hostname: sl.String(router)}}},
+	}
+	if firewall {
+		vlan.DedicatedFirewallFlag = sl.Int(1)
+	}
+	return vlan
+}
+
+func TestSelectVlanPlacement(t *testing.T) {
+	vlans := []datatypes.Network_Vlan{
+		testVlan(10, "PUBLIC", "fcr02a.dal06", true),
+		testVlan(11, "PRIVATE", "bcr02a.dal06", false),
+		testVlan(20, "PUBLIC", "fcr01a.dal06", false),
+		testVlan(21, "PRIVATE", "bcr01a.dal06", false),
+		testVlan(22, "PRIVATE", "bcr01a.dal06", false),
+		testVlan(30, "PRIVATE", "bcr03a.dal06", false),
+	}
+
+	testCases := []struct {
+		placement vlanPlacement
+		public    int
+		private   int
+	}{
+		{vlanPlacement{}, 20, 21},
+		{vlanPlacement{firewall: true}, 10, 11},
+		{vlanPlacement{pod: "02a.dal06"}, 10, 11},
+		{vlanPlacement{excludeVlanIds: map[int]bool{21: true}}, 20, 22},
+		{vlanPlacement{privateNetworkOnly: true, pod: "03a.dal06"}, 0, 30},
+	}
+
+	for _, tc := range testCases {
+		public, private, err := selectVlanPlacement(vlans, tc.placement)
+		if err != nil {
+			t.Errorf("Unexpected error for %+v: %s", tc.placement, err)
+			continue
+		}
+		if *private.Id != tc.private {
+			t.Errorf("Expected private vlan %d for %+v, got %d", tc.private, tc.placement, *private.Id)
+		}
+		if tc.public == 0 && public != nil {
+			t.Errorf("Expected no public vlan for %+v, got %d", tc.placement, *public.Id)
+		}
+		if tc.public != 0 && (public == nil || *public.Id != tc.public) {
+			t.Errorf("Expected public vlan %d for %+v, got %v", tc.public, tc.placement, public)
+		}
+	}
+
+	_, _, err := selectVlanPlacement(vlans, vlanPlacement{pod: "03a.dal06"})
+	if err == nil {
+		t.Errorf("Expected an error when the pod has no public vlan")
+	}
+}
+
+func TestRouterPod(t *testing.T) {
+	for hostname, expected := range map[string]string{
+		"fcr01a.dal06": "01a.dal06",
+		"bcr01a.dal06": "01a.dal06",
+		"bcr02.sjc01":  "02.sjc01",
+	} {
+		pod, err := routerPod(hostname)
+		if err != nil || pod != expected {
+			t.Errorf("Expected pod %s for %s, got %s (%v)", expected, hostname, pod, err)
+		}
+	}
+
+	if _, err := routerPod("router"); err == nil {
+		t.Errorf("Expected an error for an invalid router hostname")
+	}
+}
+
+const testAccCheckIBMNetworkVlanPlacementDataSourceConfig = `
+data "ibm_network_vlan_placement" "pair" {
+    datacenter = "dal06"
+}`
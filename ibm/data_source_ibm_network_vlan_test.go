@@ -31,6 +31,31 @@ func TestAccIBMNetworkVlanDataSource_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMNetworkVlanDataSource_AutoAssigned(t *testing.T) {
+
+	hostname := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMNetworkVlanDataSourceAutoAssignedConfig(hostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_network_vlan.auto_public", "id",
+						"ibm_compute_vm_instance.auto_vm", "public_vlan_id"),
+					resource.TestCheckResourceAttrPair("data.ibm_network_vlan.auto_private", "id",
+						"ibm_compute_vm_instance.auto_vm", "private_vlan_id"),
+					resource.TestMatchResourceAttr("data.ibm_network_vlan.auto_public", "router_hostname", regexp.MustCompile(`^fcr`)),
+					resource.TestMatchResourceAttr("data.ibm_network_vlan.auto_private", "router_hostname", regexp.MustCompile(`^bcr`)),
+					resource.TestMatchResourceAttr("ibm_compute_vm_instance.auto_vm", "public_subnet_id", regexp.MustCompile("^[0-9]+$")),
+					resource.TestMatchResourceAttr("ibm_compute_vm_instance.auto_vm", "private_subnet_id", regexp.MustCompile("^[0-9]+$")),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMNetworkVlanDataSourceConfig(name string) string {
 	return fmt.Sprintf(`
     resource "ibm_network_vlan" "test_vlan_private" {
@@ -45,3 +70,28 @@ data "ibm_network_vlan" "tfacc_vlan" {
     name = "${ibm_network_vlan.test_vlan_private.name}"
 }`, name)
 }
+
+func testAccCheckIBMNetworkVlanDataSourceAutoAssignedConfig(hostname string) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_vm_instance" "auto_vm" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "dal06"
+    network_speed = 10
+    hourly_billing = true
+    private_network_only = false
+    cores = 1
+    memory = 1024
+    disks = [25]
+    local_disk = false
+}
+
+data "ibm_network_vlan" "auto_public" {
+    vlan_id = "${ibm_compute_vm_instance.auto_vm.public_vlan_id}"
+}
+
+data "ibm_network_vlan" "auto_private" {
+    vlan_id = "${ibm_compute_vm_instance.auto_vm.private_vlan_id}"
+}`, hostname)
+}
@@ -0,0 +1,168 @@
+package ibm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func dataSourceIBMObjectStorageCredentials() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMObjectStorageCredentialsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Description: "The name of the object storage account, the first account when not set",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"datacenter": {
+				Description: "The short name of the datacenter of the auth endpoints, such as dal05",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"username": {
+				Description: "The Swift user name of the object storage account",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"api_key": {
+				Description: "The Swift API key of the object storage account",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+
+			"auth_url": {
+				Description: "The public Swift auth endpoint of the datacenter",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"private_auth_url": {
+				Description: "The private Swift auth endpoint of the datacenter",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"endpoints": {
+				Description: "The Swift auth endpoints of all the datacenters",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"datacenter": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datacenter_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMObjectStorageCredentialsRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	service := services.GetAccountService(sess).Mask("id,username,credentials[username,password]")
+
+	accountName := d.Get("account_name").(string)
+	if accountName != "" {
+		service = service.Filter(filter.Path("hubNetworkStorage.username").Eq(accountName).Build())
+	}
+
+	objectStorageAccounts, err := service.GetHubNetworkStorage()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the object storage accounts: %s", err)
+	}
+	if len(objectStorageAccounts) == 0 {
+		if accountName != "" {
+			return fmt.Errorf("No object storage account found with name %s", accountName)
+		}
+		return fmt.Errorf("No object storage account found")
+	}
+	storage := objectStorageAccounts[0]
+
+	connections, err := services.GetNetworkStorageService(sess).Id(*storage.Id).GetObjectStorageConnectionInformation()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the endpoints of object storage account %s: %s",
+			sl.Get(storage.Username, ""), err)
+	}
+	endpoints := flattenObjectStorageEndpoints(connections)
+
+	d.SetId(sl.Get(storage.Username, "").(string))
+	d.Set("account_name", sl.Get(storage.Username, ""))
+	d.Set("endpoints", endpoints)
+
+	username, apiKey := objectStorageCredential(storage)
+	d.Set("username", username)
+	d.Set("api_key", apiKey)
+
+	d.Set("auth_url", "")
+	d.Set("private_auth_url", "")
+	if dc, ok := d.GetOk("datacenter"); ok {
+		found := false
+		for _, endpoint := range endpoints {
+			if endpoint["datacenter"] == dc.(string) {
+				d.Set("auth_url", endpoint["public_endpoint"])
+				d.Set("private_auth_url", endpoint["private_endpoint"])
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("No object storage endpoint found in datacenter %s", dc.(string))
+		}
+	}
+
+	return nil
+}
+
+// objectStorageCredential returns the Swift user name and API key of the object storage account.
+// The user name of the account is used when it has no credentials.
+func objectStorageCredential(storage datatypes.Network_Storage) (string, string) {
+	for _, credential := range storage.Credentials {
+		if credential.Username != nil {
+			return *credential.Username, sl.Get(credential.Password, "").(string)
+		}
+	}
+	return sl.Get(storage.Username, "").(string), ""
+}
+
+// flattenObjectStorageEndpoints returns the Swift auth endpoints in the order of the datacenters
+func flattenObjectStorageEndpoints(connections []datatypes.Container_Network_Service_Resource_ObjectStorage_ConnectionInformation) []map[string]interface{} {
+	endpoints := make([]map[string]interface{}, 0, len(connections))
+	for _, connection := range connections {
+		endpoints = append(endpoints, map[string]interface{}{
+			"datacenter":       sl.Get(connection.DatacenterShortName, ""),
+			"datacenter_name":  sl.Get(connection.Datacenter, ""),
+			"public_endpoint":  sl.Get(connection.PublicEndpoint, ""),
+			"private_endpoint": sl.Get(connection.PrivateEndpoint, ""),
+		})
+	}
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return endpoints[i]["datacenter"].(string) < endpoints[j]["datacenter"].(string)
+	})
+	return endpoints
+}
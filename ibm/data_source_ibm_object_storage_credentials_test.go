@@ -0,0 +1,93 @@
+package ibm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMObjectStorageCredentialsDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMObjectStorageCredentialsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_object_storage_credentials.swift", "account_name",
+						"ibm_object_storage_account.swift", "name"),
+					resource.TestCheckResourceAttrSet("data.ibm_object_storage_credentials.swift", "username"),
+					resource.TestCheckResourceAttrSet("data.ibm_object_storage_credentials.swift", "api_key"),
+					resource.TestCheckResourceAttrSet("data.ibm_object_storage_credentials.swift", "auth_url"),
+					resource.TestCheckResourceAttrSet("data.ibm_object_storage_credentials.swift", "endpoints.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestObjectStorageCredential(t *testing.T) {
+	storage := datatypes.Network_Storage{
+		Username: sl.String("SLOS123-2"),
+		Credentials: []datatypes.Network_Storage_Credential{
+			{Username: sl.String("SLOS123-2:SL123"), Password: sl.String("secret")},
+		},
+	}
+	username, apiKey := objectStorageCredential(storage)
+	if username != "SLOS123-2:SL123" || apiKey != "secret" {
+		t.Errorf("Expected credential SLOS123-2:SL123/secret, got %s/%s", username, apiKey)
+	}
+
+	storage.Credentials = nil
+	username, apiKey = objectStorageCredential(storage)
+	if username != "SLOS123-2" || apiKey != "" {
+		t.Errorf("Expected the account name without API key, got %s/%s", username, apiKey)
+	}
+}
+
+func TestFlattenObjectStorageEndpoints(t *testing.T) {
+	connections := []datatypes.Container_Network_Service_Resource_ObjectStorage_ConnectionInformation{
+		{
+			Datacenter:          sl.String("Tokyo 2"),
+			DatacenterShortName: sl.String("tok02"),
+			PublicEndpoint:      sl.String("https://tok02.objectstorage.softlayer.net/auth/v1.0/"),
+			PrivateEndpoint:     sl.String("https://tok02.objectstorage.service.networklayer.com/auth/v1.0/"),
+		},
+		{
+			Datacenter:          sl.String("Dallas 5"),
+			DatacenterShortName: sl.String("dal05"),
+			PublicEndpoint:      sl.String("https://dal05.objectstorage.softlayer.net/auth/v1.0/"),
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"datacenter":       "dal05",
+			"datacenter_name":  "Dallas 5",
+			"public_endpoint":  "https://dal05.objectstorage.softlayer.net/auth/v1.0/",
+			"private_endpoint": "",
+		},
+		{
+			"datacenter":       "tok02",
+			"datacenter_name":  "Tokyo 2",
+			"public_endpoint":  "https://tok02.objectstorage.softlayer.net/auth/v1.0/",
+			"private_endpoint": "https://tok02.objectstorage.service.networklayer.com/auth/v1.0/",
+		},
+	}
+	if endpoints := flattenObjectStorageEndpoints(connections); !reflect.DeepEqual(endpoints, expected) {
+		t.Errorf("Expected endpoints %v, got %v", expected, endpoints)
+	}
+}
+
+const testAccCheckIBMObjectStorageCredentialsDataSourceConfig = `
+resource "ibm_object_storage_account" "swift" {
+}
+
+data "ibm_object_storage_credentials" "swift" {
+    account_name = "${ibm_object_storage_account.swift.name}"
+    datacenter = "dal05"
+}
+`
@@ -27,7 +27,7 @@ func dataSourceIBMOrgRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	orgAPI := cfAPI.Organizations()
 	org := d.Get("org").(string)
-	orgFields, err := orgAPI.FindByName(org, BluemixRegion)
+	orgFields, err := orgAPI.FindByName(org, meta.(ClientSession).BluemixRegion())
 	if err != nil {
 		return fmt.Errorf("Error retrieving organisation: %s", err)
 	}
@@ -0,0 +1,194 @@
+package ibm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/helpers/location"
+	"github.com/softlayer/softlayer-go/helpers/product"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const productPriceItemMask = "id,keyName,description,prices[id,locationGroupId,hourlyRecurringFee,recurringFee,setupFee,oneTimeFee]"
+
+func dataSourceIBMProductPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMProductPriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"package_type": {
+				Description: "The type of the product package, for example ADDITIONAL_SERVICES_NETWORK_VLAN",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"item_keynames": {
+				Description: "The key names of the items to price",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"datacenter": {
+				Description: "The datacenter in which the items are ordered. Location specific prices are used when available",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"hourly_recurring_fee": {
+				Description: "The total hourly recurring fee of the items",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"recurring_fee": {
+				Description: "The total monthly recurring fee of the items",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"setup_fee": {
+				Description: "The total setup fee of the items",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"one_time_fee": {
+				Description: "The total one time fee of the items",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"items": {
+				Description: "The price of each item",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"price_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"hourly_recurring_fee": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"recurring_fee": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"setup_fee": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"one_time_fee": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMProductPriceRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	packageType := d.Get("package_type").(string)
+	keyNames := expandStringList(d.Get("item_keynames").([]interface{}))
+	datacenter := d.Get("datacenter").(string)
+
+	priceGroups := []int{}
+	if datacenter != "" {
+		dc, err := location.GetDatacenterByName(sess, datacenter, "id,priceGroups[id]")
+		if err != nil {
+			return fmt.Errorf("Error retrieving datacenter %s: %s", datacenter, err)
+		}
+		for _, group := range dc.PriceGroups {
+			priceGroups = append(priceGroups, *group.Id)
+		}
+	}
+
+	pkg, err := product.GetPackageByType(sess, packageType)
+	if err != nil {
+		return fmt.Errorf("Error retrieving package %s: %s", packageType, err)
+	}
+
+	productItems, err := product.GetPackageProducts(sess, *pkg.Id, productPriceItemMask)
+	if err != nil {
+		return fmt.Errorf("Error retrieving items of package %s: %s", packageType, err)
+	}
+
+	var hourly, recurring, setup, oneTime float64
+	items := make([]map[string]interface{}, 0, len(keyNames))
+	for _, keyName := range keyNames {
+		item, price, err := findProductItemPrice(productItems, keyName, priceGroups)
+		if err != nil {
+			return fmt.Errorf("Error pricing items of package %s: %s", packageType, err)
+		}
+
+		itemHourly := float64(sl.Get(price.HourlyRecurringFee, datatypes.Float64(0)).(datatypes.Float64))
+		itemRecurring := float64(sl.Get(price.RecurringFee, datatypes.Float64(0)).(datatypes.Float64))
+		itemSetup := float64(sl.Get(price.SetupFee, datatypes.Float64(0)).(datatypes.Float64))
+		itemOneTime := float64(sl.Get(price.OneTimeFee, datatypes.Float64(0)).(datatypes.Float64))
+
+		hourly += itemHourly
+		recurring += itemRecurring
+		setup += itemSetup
+		oneTime += itemOneTime
+
+		items = append(items, map[string]interface{}{
+			"key_name":             keyName,
+			"description":          sl.Get(item.Description, ""),
+			"price_id":             *price.Id,
+			"hourly_recurring_fee": itemHourly,
+			"recurring_fee":        itemRecurring,
+			"setup_fee":            itemSetup,
+			"one_time_fee":         itemOneTime,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d:%s:%s", *pkg.Id, datacenter, strings.Join(keyNames, ",")))
+	d.Set("hourly_recurring_fee", hourly)
+	d.Set("recurring_fee", recurring)
+	d.Set("setup_fee", setup)
+	d.Set("one_time_fee", oneTime)
+	d.Set("items", items)
+
+	return nil
+}
+
+// findProductItemPrice returns the item with the key name and its price. The price of one of the
+// location price groups is preferred to the standard price, which has no location group.
+func findProductItemPrice(items []datatypes.Product_Item, keyName string, priceGroups []int) (datatypes.Product_Item, datatypes.Product_Item_Price, error) {
+	for _, item := range items {
+		if item.KeyName == nil || *item.KeyName != keyName {
+			continue
+		}
+
+		var standard *datatypes.Product_Item_Price
+		for i, price := range item.Prices {
+			if price.LocationGroupId == nil {
+				if standard == nil {
+					standard = &item.Prices[i]
+				}
+				continue
+			}
+			for _, group := range priceGroups {
+				if *price.LocationGroupId == group {
+					return item, price, nil
+				}
+			}
+		}
+		if standard != nil {
+			return item, *standard, nil
+		}
+		return item, datatypes.Product_Item_Price{}, fmt.Errorf("No price found for item %s", keyName)
+	}
+	return datatypes.Product_Item{}, datatypes.Product_Item_Price{}, fmt.Errorf("No product items matching %s could be found", keyName)
+}
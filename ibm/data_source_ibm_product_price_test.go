@@ -0,0 +1,33 @@
+package ibm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMProductPriceDataSource_Basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMProductPriceDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_product_price.vlan", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_product_price.vlan", "items.0.key_name", "PUBLIC_NETWORK_VLAN"),
+					resource.TestCheckResourceAttrSet("data.ibm_product_price.vlan", "recurring_fee"),
+					resource.TestCheckResourceAttrSet("data.ibm_product_price.vlan", "items.0.price_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckIBMProductPriceDataSourceConfig_basic = `
+data "ibm_product_price" "vlan" {
+    package_type  = "ADDITIONAL_SERVICES_NETWORK_VLAN"
+    item_keynames = ["PUBLIC_NETWORK_VLAN", "8_STATIC_PUBLIC_IP_ADDRESSES"]
+    datacenter    = "dal06"
+}`
@@ -56,6 +56,12 @@ func dataSourceIBMServiceInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+
+			"dashboard_url": {
+				Description: "The URL of the dashboard of the service instance",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -83,6 +89,7 @@ func dataSourceIBMServiceInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set("credentials", flattenCredentials(serviceInstance.Entity.Credentials))
 	d.Set("service_keys", flattenServiceInstanceCredentials(serviceKeys))
 	d.Set("service_plan_guid", serviceInstance.Entity.ServicePlanGUID)
+	d.Set("dashboard_url", serviceInstance.Entity.DashboardURL)
 
 	return nil
 }
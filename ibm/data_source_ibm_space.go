@@ -54,11 +54,11 @@ func dataSourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
 	space := d.Get("space").(string)
 	org := d.Get("org").(string)
 
-	orgFields, err := orgAPI.FindByName(org, BluemixRegion)
+	orgFields, err := orgAPI.FindByName(org, meta.(ClientSession).BluemixRegion())
 	if err != nil {
 		return fmt.Errorf("Error retrieving org: %s", err)
 	}
-	spaceFields, err := spaceAPI.FindByNameInOrg(orgFields.GUID, space, BluemixRegion)
+	spaceFields, err := spaceAPI.FindByNameInOrg(orgFields.GUID, space, meta.(ClientSession).BluemixRegion())
 	if err != nil {
 		return fmt.Errorf("Error retrieving space: %s", err)
 	}
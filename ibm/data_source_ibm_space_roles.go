@@ -0,0 +1,152 @@
+package ibm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/IBM-Bluemix/bluemix-go/api/mccp/mccpv2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceIBMSpaceRoles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMSpaceRolesRead,
+
+		Schema: map[string]*schema.Schema{
+			"org": {
+				Description: "The org of the spaces",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"space": {
+				Description: "The name of the space, all the spaces of the org when not set",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"spaces": {
+				Description: "The users of the spaces by role",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"guid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"managers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"developers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"auditors": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"managers": {
+				Description: "The users who have the manager role in any of the spaces",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceIBMSpaceRolesRead(d *schema.ResourceData, meta interface{}) error {
+	cfClient, err := meta.(ClientSession).MccpAPI()
+	if err != nil {
+		return err
+	}
+	spaceAPI := cfClient.Spaces()
+
+	org := d.Get("org").(string)
+	orgFields, err := cfClient.Organizations().FindByName(org, meta.(ClientSession).BluemixRegion())
+	if err != nil {
+		return fmt.Errorf("Error retrieving org: %s", err)
+	}
+
+	var spaces []mccpv2.Space
+	if space, ok := d.GetOk("space"); ok {
+		spaceFields, err := spaceAPI.FindByNameInOrg(orgFields.GUID, space.(string), meta.(ClientSession).BluemixRegion())
+		if err != nil {
+			return fmt.Errorf("Error retrieving space: %s", err)
+		}
+		spaces = []mccpv2.Space{*spaceFields}
+	} else {
+		spaces, err = spaceAPI.ListSpacesInOrg(orgFields.GUID, meta.(ClientSession).BluemixRegion())
+		if err != nil {
+			return fmt.Errorf("Error retrieving spaces of org %s: %s", org, err)
+		}
+	}
+	sort.Slice(spaces, func(i, j int) bool {
+		return spaces[i].Name < spaces[j].Name
+	})
+
+	spaceRoles := make([]map[string]interface{}, 0, len(spaces))
+	for _, space := range spaces {
+		managers, err := spaceAPI.ListManagers(space.GUID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving managers in the space %s: %s", space.Name, err)
+		}
+		developers, err := spaceAPI.ListDevelopers(space.GUID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving developers in the space %s: %s", space.Name, err)
+		}
+		auditors, err := spaceAPI.ListAuditors(space.GUID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving auditors in the space %s: %s", space.Name, err)
+		}
+		spaceRoles = append(spaceRoles, map[string]interface{}{
+			"name":       space.Name,
+			"guid":       space.GUID,
+			"managers":   sortedSpaceRoleUsers(managers),
+			"developers": sortedSpaceRoleUsers(developers),
+			"auditors":   sortedSpaceRoleUsers(auditors),
+		})
+	}
+
+	d.SetId(orgFields.GUID)
+	d.Set("spaces", spaceRoles)
+	d.Set("managers", spaceRolesUnion(spaceRoles, "managers"))
+
+	return nil
+}
+
+// sortedSpaceRoleUsers returns the sorted names of the users of a space role
+func sortedSpaceRoleUsers(roles []mccpv2.SpaceRole) []string {
+	users := make([]string, 0, len(roles))
+	for _, role := range roles {
+		users = append(users, role.UserName)
+	}
+	sort.Strings(users)
+	return users
+}
+
+// spaceRolesUnion returns the sorted users who have the role in any of the spaces
+func spaceRolesUnion(spaceRoles []map[string]interface{}, role string) []string {
+	seen := map[string]bool{}
+	users := []string{}
+	for _, space := range spaceRoles {
+		for _, user := range space[role].([]string) {
+			if !seen[user] {
+				seen[user] = true
+				users = append(users, user)
+			}
+		}
+	}
+	sort.Strings(users)
+	return users
+}
@@ -0,0 +1,51 @@
+package ibm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/IBM-Bluemix/bluemix-go/api/mccp/mccpv2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMSpaceRolesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMSpaceRolesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_space_roles.testacc_ds_space_roles", "spaces.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_space_roles.testacc_ds_space_roles", "spaces.0.name", cfSpace),
+					resource.TestCheckResourceAttrSet("data.ibm_space_roles.testacc_ds_space_roles", "managers.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestSpaceRolesUnion(t *testing.T) {
+	spaceRoles := []map[string]interface{}{
+		{"managers": sortedSpaceRoleUsers([]mccpv2.SpaceRole{{UserName: "zed@example.com"}, {UserName: "alice@example.com"}})},
+		{"managers": sortedSpaceRoleUsers([]mccpv2.SpaceRole{{UserName: "alice@example.com"}, {UserName: "bob@example.com"}})},
+		{"managers": sortedSpaceRoleUsers(nil)},
+	}
+	if users := spaceRoles[0]["managers"]; !reflect.DeepEqual(users, []string{"alice@example.com", "zed@example.com"}) {
+		t.Errorf("Expected the sorted managers of the space, got %v", users)
+	}
+	expected := []string{"alice@example.com", "bob@example.com", "zed@example.com"}
+	if users := spaceRolesUnion(spaceRoles, "managers"); !reflect.DeepEqual(users, expected) {
+		t.Errorf("Expected %v, got %v", expected, users)
+	}
+}
+
+func testAccCheckIBMSpaceRolesDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "ibm_space_roles" "testacc_ds_space_roles" {
+    org = "%s"
+	space = "%s"
+}`, cfOrganization, cfSpace)
+
+}
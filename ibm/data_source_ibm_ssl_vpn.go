@@ -0,0 +1,72 @@
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/helpers/location"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func dataSourceIBMSslVpn() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMSslVpnRead,
+
+		Schema: map[string]*schema.Schema{
+			"datacenter": {
+				Description: "The datacenter of the SSL VPN endpoint",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"hostname": {
+				Description: "The hostname of the SSL VPN endpoint, built from the name of the datacenter",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"url": {
+				Description: "The URL of the SSL VPN endpoint",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ssl_vpn_allowed": {
+				Description: "Whether the user of the provider may connect to the private network through SSL VPN",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMSslVpnRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	datacenter := d.Get("datacenter").(string)
+
+	dc, err := location.GetDatacenterByName(sess, datacenter, "id,name")
+	if err != nil {
+		return fmt.Errorf("Error retrieving datacenter %s: %s", datacenter, err)
+	}
+
+	user, err := services.GetAccountService(sess).Mask("id,sslVpnAllowedFlag").GetCurrentUser()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the current user: %s", err)
+	}
+
+	hostname := sslVpnHostname(*dc.Name)
+	d.SetId(fmt.Sprintf("%d", *dc.Id))
+	d.Set("hostname", hostname)
+	d.Set("url", "https://"+hostname)
+	d.Set("ssl_vpn_allowed", sl.Get(user.SslVpnAllowedFlag, false))
+
+	return nil
+}
+
+// sslVpnHostname returns the hostname of the SSL VPN endpoint of a datacenter, such as
+// vpn.dal06.softlayer.com. The API does not list the SSL VPN endpoints, so the hostname is built
+// from the naming convention of the endpoints and is not verified.
+func sslVpnHostname(datacenter string) string {
+	return fmt.Sprintf("vpn.%s.softlayer.com", datacenter)
+}
@@ -0,0 +1,30 @@
+package ibm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMSslVpnDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSslVpnDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_ssl_vpn.dal06", "hostname", "vpn.dal06.softlayer.com"),
+					resource.TestCheckResourceAttr("data.ibm_ssl_vpn.dal06", "url", "https://vpn.dal06.softlayer.com"),
+					resource.TestCheckResourceAttrSet("data.ibm_ssl_vpn.dal06", "ssl_vpn_allowed"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckIBMSslVpnDataSourceConfig = `
+data "ibm_ssl_vpn" "dal06" {
+    datacenter = "dal06"
+}
+`
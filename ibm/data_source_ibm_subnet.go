@@ -0,0 +1,307 @@
+package ibm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const (
+	subnetMask = "id,networkIdentifier,cidr,subnetType,netmask,gateway,broadcastAddress," +
+		"version,networkVlanId,datacenter[name],ipAddressCount,usableIpAddressCount,note"
+
+	subnetIpAddressMask = "id,ipAddress,note,isNetwork,isGateway,isBroadcast,isReserved," +
+		"virtualGuest[id],hardware[id]"
+
+	ipAddressStatusNetwork   = "NETWORK"
+	ipAddressStatusGateway   = "GATEWAY"
+	ipAddressStatusBroadcast = "BROADCAST"
+	ipAddressStatusReserved  = "RESERVED"
+	ipAddressStatusAssigned  = "ASSIGNED"
+	ipAddressStatusAvailable = "AVAILABLE"
+)
+
+func dataSourceIBMSubnet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMSubnetRead,
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Description:   "The ID of the subnet",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"subnet"},
+			},
+
+			"subnet": {
+				Description:   "The subnet, in CIDR notation",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"subnet_id"},
+			},
+
+			"include_ip_addresses": {
+				Description: "Whether to list the IP addresses of the subnet",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"network_identifier": {
+				Description: "The network identifier of the subnet",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"cidr": {
+				Description: "The CIDR prefix length of the subnet",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"subnet_type": {
+				Description: "The type of the subnet",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ip_version": {
+				Description: "The IP version of the subnet",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"netmask": {
+				Description: "The netmask of the subnet",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"gateway": {
+				Description: "The gateway IP address of the subnet",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"broadcast_address": {
+				Description: "The broadcast IP address of the subnet",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"vlan_id": {
+				Description: "The ID of the VLAN of the subnet",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"datacenter": {
+				Description: "The datacenter of the subnet",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ip_address_count": {
+				Description: "The number of IP addresses of the subnet",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"usable_ip_address_count": {
+				Description: "The number of IP addresses of the subnet usable by servers",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"notes": {
+				Description: "The notes of the subnet",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ip_addresses": {
+				Description: "The IP addresses of the subnet, when include_ip_addresses is set",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"virtual_guest_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"hardware_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"notes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"available_ip_addresses": {
+				Description: "The IP addresses of the subnet which are not assigned, when include_ip_addresses is set",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceIBMSubnetRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	var subnet datatypes.Network_Subnet
+	if id, ok := d.GetOk("subnet_id"); ok {
+		var err error
+		subnet, err = services.GetNetworkSubnetService(sess).Id(id.(int)).Mask(subnetMask).GetObject()
+		if err != nil {
+			return fmt.Errorf("Error retrieving subnet %d: %s", id.(int), err)
+		}
+	} else {
+		cidrNotation := d.Get("subnet").(string)
+		if cidrNotation == "" {
+			return fmt.Errorf("One of subnet_id or subnet must be set")
+		}
+		networkIdentifier, cidr, err := parseSubnetCIDR(cidrNotation)
+		if err != nil {
+			return err
+		}
+
+		subnets, err := getAccountSubnets(services.GetAccountService(sess).
+			Filter(filter.Build(
+				filter.Path("subnets.networkIdentifier").Eq(networkIdentifier),
+				filter.Path("subnets.cidr").Eq(cidr))).
+			Mask(subnetMask))
+		if err != nil {
+			return fmt.Errorf("Error retrieving subnet %s: %s", cidrNotation, err)
+		}
+		if len(subnets) == 0 {
+			return fmt.Errorf("No subnet %s was found", cidrNotation)
+		}
+		subnet = subnets[0]
+	}
+
+	d.SetId(fmt.Sprintf("%d", *subnet.Id))
+	d.Set("subnet_id", *subnet.Id)
+	d.Set("subnet", fmt.Sprintf("%s/%d", *subnet.NetworkIdentifier, *subnet.Cidr))
+	d.Set("network_identifier", *subnet.NetworkIdentifier)
+	d.Set("cidr", *subnet.Cidr)
+	d.Set("subnet_type", sl.Get(subnet.SubnetType, ""))
+	d.Set("ip_version", sl.Get(subnet.Version, 0))
+	d.Set("netmask", sl.Get(subnet.Netmask, ""))
+	d.Set("gateway", sl.Get(subnet.Gateway, ""))
+	d.Set("broadcast_address", sl.Get(subnet.BroadcastAddress, ""))
+	d.Set("vlan_id", sl.Get(subnet.NetworkVlanId, 0))
+	d.Set("datacenter", sl.Grab(subnet, "Datacenter.Name", ""))
+	d.Set("ip_address_count", int(sl.Get(subnet.IpAddressCount, uint(0)).(uint)))
+	d.Set("usable_ip_address_count", int(sl.Get(subnet.UsableIpAddressCount, datatypes.Float64(0)).(datatypes.Float64)))
+	d.Set("notes", sl.Get(subnet.Note, ""))
+
+	if !d.Get("include_ip_addresses").(bool) {
+		d.Set("ip_addresses", []map[string]interface{}{})
+		d.Set("available_ip_addresses", []string{})
+		return nil
+	}
+
+	ipAddresses, err := getSubnetIpAddresses(services.GetNetworkSubnetService(sess).
+		Id(*subnet.Id).
+		Mask(subnetIpAddressMask))
+	if err != nil {
+		return fmt.Errorf("Error retrieving the IP addresses of subnet %d: %s", *subnet.Id, err)
+	}
+
+	ips, available := flattenSubnetIpAddresses(ipAddresses)
+	d.Set("ip_addresses", ips)
+	d.Set("available_ip_addresses", available)
+
+	return nil
+}
+
+// getSubnetIpAddresses lists all the IP addresses of the subnet matching the mask already set on
+// service. Large subnets are paginated like the account list calls.
+func getSubnetIpAddresses(service services.Network_Subnet) ([]datatypes.Network_Subnet_IpAddress, error) {
+	result := []datatypes.Network_Subnet_IpAddress{}
+	err := paginate(accountResultLimit, func(offset, limit int) (int, error) {
+		ipAddresses, err := service.Offset(offset).Limit(limit).GetIpAddresses()
+		result = append(result, ipAddresses...)
+		return len(ipAddresses), err
+	})
+	return result, err
+}
+
+// flattenSubnetIpAddresses returns the IP address records of a subnet with their assignment
+// status, and the IP addresses which are free to assign
+func flattenSubnetIpAddresses(ipAddresses []datatypes.Network_Subnet_IpAddress) ([]map[string]interface{}, []string) {
+	ips := make([]map[string]interface{}, 0, len(ipAddresses))
+	available := make([]string, 0)
+	for _, ipAddress := range ipAddresses {
+		status := subnetIpAddressStatus(ipAddress)
+		ip := map[string]interface{}{
+			"id":               sl.Get(ipAddress.Id, 0),
+			"ip_address":       sl.Get(ipAddress.IpAddress, ""),
+			"status":           status,
+			"virtual_guest_id": sl.Grab(ipAddress, "VirtualGuest.Id", 0),
+			"hardware_id":      sl.Grab(ipAddress, "Hardware.Id", 0),
+			"notes":            sl.Get(ipAddress.Note, ""),
+		}
+		ips = append(ips, ip)
+		if status == ipAddressStatusAvailable {
+			available = append(available, ip["ip_address"].(string))
+		}
+	}
+	return ips, available
+}
+
+func subnetIpAddressStatus(ipAddress datatypes.Network_Subnet_IpAddress) string {
+	switch {
+	case sl.Get(ipAddress.IsNetwork, false).(bool):
+		return ipAddressStatusNetwork
+	case sl.Get(ipAddress.IsGateway, false).(bool):
+		return ipAddressStatusGateway
+	case sl.Get(ipAddress.IsBroadcast, false).(bool):
+		return ipAddressStatusBroadcast
+	case sl.Get(ipAddress.IsReserved, false).(bool):
+		return ipAddressStatusReserved
+	case ipAddress.VirtualGuest != nil || ipAddress.Hardware != nil:
+		return ipAddressStatusAssigned
+	}
+	return ipAddressStatusAvailable
+}
+
+// parseSubnetCIDR splits a subnet in CIDR notation, such as 10.0.0.0/29, into its network
+// identifier and prefix length
+func parseSubnetCIDR(subnet string) (string, int, error) {
+	parts := strings.Split(subnet, "/")
+	if len(parts) != 2 {
+		return "", 0, fmt.Errorf("Not a valid subnet, must be in CIDR notation: %s", subnet)
+	}
+	cidr, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, fmt.Errorf("Not a valid subnet, the prefix length must be an integer: %s", subnet)
+	}
+	return parts[0], cidr, nil
+}
@@ -0,0 +1,106 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMSubnetDataSource_Basic(t *testing.T) {
+
+	name := fmt.Sprintf("terraformuat_vlan_%s", acctest.RandString(2))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSubnetDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_subnet.tfacc_subnet", "subnet",
+						"data.ibm_network_vlan_details.tfacc_vlan", "subnets.0.subnet"),
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_subnet.tfacc_subnet", "vlan_id",
+						"ibm_network_vlan.test_vlan_private", "id"),
+					resource.TestCheckResourceAttr("data.ibm_subnet.tfacc_subnet", "cidr", "29"),
+					resource.TestCheckResourceAttr("data.ibm_subnet.tfacc_subnet", "datacenter", "dal06"),
+					resource.TestCheckResourceAttr("data.ibm_subnet.tfacc_subnet", "ip_addresses.#", "8"),
+					resource.TestCheckResourceAttrSet("data.ibm_subnet.tfacc_subnet", "available_ip_addresses.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenSubnetIpAddresses(t *testing.T) {
+	ipAddresses := []datatypes.Network_Subnet_IpAddress{
+		{Id: sl.Int(1), IpAddress: sl.String("10.0.0.0"), IsNetwork: sl.Bool(true)},
+		{Id: sl.Int(2), IpAddress: sl.String("10.0.0.1"), IsGateway: sl.Bool(true)},
+		{Id: sl.Int(3), IpAddress: sl.String("10.0.0.2"), IsReserved: sl.Bool(true)},
+		{Id: sl.Int(4), IpAddress: sl.String("10.0.0.3"), VirtualGuest: &datatypes.Virtual_Guest{Id: sl.Int(42)}},
+		{Id: sl.Int(5), IpAddress: sl.String("10.0.0.4"), Note: sl.String("free")},
+		{Id: sl.Int(6), IpAddress: sl.String("10.0.0.5"), IsBroadcast: sl.Bool(true)},
+	}
+
+	ips, available := flattenSubnetIpAddresses(ipAddresses)
+
+	expected := []string{
+		ipAddressStatusNetwork,
+		ipAddressStatusGateway,
+		ipAddressStatusReserved,
+		ipAddressStatusAssigned,
+		ipAddressStatusAvailable,
+		ipAddressStatusBroadcast,
+	}
+	for i, status := range expected {
+		if ips[i]["status"] != status {
+			t.Errorf("Expected status %s for %s, got %s", status, ips[i]["ip_address"], ips[i]["status"])
+		}
+	}
+	if ips[3]["virtual_guest_id"] != 42 {
+		t.Errorf("Expected virtual guest 42, got %v", ips[3]["virtual_guest_id"])
+	}
+	if len(available) != 1 || available[0] != "10.0.0.4" {
+		t.Errorf("Expected only 10.0.0.4 to be available, got %v", available)
+	}
+}
+
+func TestParseSubnetCIDR(t *testing.T) {
+	networkIdentifier, cidr, err := parseSubnetCIDR("10.0.0.0/29")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if networkIdentifier != "10.0.0.0" || cidr != 29 {
+		t.Errorf("Expected 10.0.0.0 and 29, got %s and %d", networkIdentifier, cidr)
+	}
+
+	for _, subnet := range []string{"10.0.0.0", "10.0.0.0/abc"} {
+		if _, _, err := parseSubnetCIDR(subnet); err == nil {
+			t.Errorf("Expected an error for %s", subnet)
+		}
+	}
+}
+
+func testAccCheckIBMSubnetDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ibm_network_vlan" "test_vlan_private" {
+    name            = "%s"
+    datacenter      = "dal06"
+    type            = "PRIVATE"
+    subnet_size     = 8
+}
+
+data "ibm_network_vlan_details" "tfacc_vlan" {
+    vlan_id = "${ibm_network_vlan.test_vlan_private.id}"
+}
+
+data "ibm_subnet" "tfacc_subnet" {
+    subnet               = "${data.ibm_network_vlan_details.tfacc_vlan.subnets.0.subnet}"
+    include_ip_addresses = true
+}`, name)
+}
@@ -0,0 +1,102 @@
+package ibm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// The key names of the types of the tagged resources, by attribute of the data source
+var tagTypeAttributes = map[string]string{
+	"GUEST":                 "vm_instance_ids",
+	"HARDWARE":              "bare_metal_ids",
+	"NETWORK_VLAN":          "vlan_ids",
+	"NETWORK_VLAN_FIREWALL": "firewall_ids",
+}
+
+func dataSourceIBMTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Description: "The name of the tag",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"vm_instance_ids": {
+				Description: "The IDs of the virtual guests with the tag",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"bare_metal_ids": {
+				Description: "The IDs of the bare metal servers with the tag",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"vlan_ids": {
+				Description: "The IDs of the VLANs with the tag",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"firewall_ids": {
+				Description: "The IDs of the dedicated firewalls with the tag",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceIBMTagsRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	name := d.Get("tag").(string)
+
+	tags, err := services.GetTagService(sess).
+		Mask("id,name,references[resourceTableId,tagType[keyName]]").
+		GetTagByTagName(sl.String(name))
+	if err != nil {
+		return fmt.Errorf("Error retrieving tag %s: %s", name, err)
+	}
+
+	d.SetId(name)
+	for attribute, ids := range flattenTaggedResourceIDs(tags) {
+		d.Set(attribute, ids)
+	}
+
+	return nil
+}
+
+// flattenTaggedResourceIDs returns the sorted IDs of the resources referenced by the tags, by
+// attribute of the data source. The references to the other types of resources are ignored.
+func flattenTaggedResourceIDs(tags []datatypes.Tag) map[string][]int {
+	ids := make(map[string][]int, len(tagTypeAttributes))
+	for _, attribute := range tagTypeAttributes {
+		ids[attribute] = make([]int, 0)
+	}
+	for _, tag := range tags {
+		for _, ref := range tag.References {
+			attribute, ok := tagTypeAttributes[sl.Grab(ref, "TagType.KeyName", "").(string)]
+			if !ok || ref.ResourceTableId == nil {
+				continue
+			}
+			ids[attribute] = append(ids[attribute], *ref.ResourceTableId)
+		}
+	}
+	for _, list := range ids {
+		sort.Ints(list)
+	}
+	return ids
+}
@@ -0,0 +1,76 @@
+package ibm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMTagsDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTagsDataSourceConfig("tags-data-source"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_tags.tagged", "vlan_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_tags.tagged", "vlan_ids.0", "ibm_network_vlan.tagged", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenTaggedResourceIDs(t *testing.T) {
+	ref := func(keyName string, id int) datatypes.Tag_Reference {
+		return datatypes.Tag_Reference{
+			ResourceTableId: sl.Int(id),
+			TagType:         &datatypes.Tag_Type{KeyName: sl.String(keyName)},
+		}
+	}
+	tags := []datatypes.Tag{
+		{
+			Name: sl.String("production"),
+			References: []datatypes.Tag_Reference{
+				ref("GUEST", 12),
+				ref("NETWORK_VLAN", 30),
+				ref("GUEST", 11),
+				ref("TICKET", 40),
+				ref("NETWORK_VLAN_FIREWALL", 50),
+			},
+		},
+	}
+
+	expected := map[string][]int{
+		"vm_instance_ids": {11, 12},
+		"bare_metal_ids":  {},
+		"vlan_ids":        {30},
+		"firewall_ids":    {50},
+	}
+	if ids := flattenTaggedResourceIDs(tags); !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Expected %v, got %v", expected, ids)
+	}
+}
+
+func testAccCheckIBMTagsDataSourceConfig(tag string) string {
+	return fmt.Sprintf(`
+resource "ibm_network_vlan" "tagged" {
+	name = "test_vlan_tags"
+	datacenter = "lon02"
+	type = "PUBLIC"
+	subnet_size = 8
+	router_hostname = "fcr01a.lon02"
+	tags = ["%s"]
+}
+
+data "ibm_tags" "tagged" {
+	tag        = "%s"
+	depends_on = ["ibm_network_vlan.tagged"]
+}`, tag, tag)
+}
@@ -0,0 +1,117 @@
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const vpnGatewayMask = "id,name,networkSpace,publicIpAddress[ipAddress],privateIpAddress[ipAddress]," +
+	"publicVlanId,privateVlanId,status[keyName],members[hardwareId,priority]"
+
+func dataSourceIBMVpnGateway() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMVpnGatewayRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the network gateway",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"network_space": {
+				Description: "The network space of the gateway, PRIVATE or BOTH",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"public_ip_address": {
+				Description: "The public IP address of the gateway",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"private_ip_address": {
+				Description: "The private IP address of the gateway",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"public_vlan_id": {
+				Description: "The ID of the public VLAN of the gateway",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"private_vlan_id": {
+				Description: "The ID of the private VLAN of the gateway",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"status": {
+				Description: "The status of the gateway",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"members": {
+				Description: "The bare metal servers of the gateway",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hardware_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	name := d.Get("name").(string)
+
+	gateways, err := services.GetAccountService(sess).
+		Filter(filter.Path("networkGateways.name").Eq(name).Build()).
+		Mask(vpnGatewayMask).
+		GetNetworkGateways()
+	if err != nil {
+		return fmt.Errorf("Error retrieving network gateway %s: %s", name, err)
+	}
+	if len(gateways) == 0 {
+		return fmt.Errorf("No network gateway was found with the name %s", name)
+	}
+	gw := gateways[0]
+
+	members := make([]map[string]interface{}, 0, len(gw.Members))
+	for _, m := range gw.Members {
+		members = append(members, map[string]interface{}{
+			"hardware_id": sl.Get(m.HardwareId, 0),
+			"priority":    sl.Get(m.Priority, 0),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", *gw.Id))
+	d.Set("network_space", sl.Get(gw.NetworkSpace, ""))
+	d.Set("public_ip_address", sl.Grab(gw, "PublicIpAddress.IpAddress", ""))
+	d.Set("private_ip_address", sl.Grab(gw, "PrivateIpAddress.IpAddress", ""))
+	d.Set("public_vlan_id", sl.Get(gw.PublicVlanId, 0))
+	d.Set("private_vlan_id", sl.Get(gw.PrivateVlanId, 0))
+	d.Set("status", sl.Grab(gw, "Status.KeyName", ""))
+	d.Set("members", members)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMVpnGatewayDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMVpnGatewayDataSourceConfig(networkGatewayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_vpn_gateway.gateway", "name", networkGatewayName),
+					resource.TestCheckResourceAttrSet("data.ibm_vpn_gateway.gateway", "private_ip_address"),
+					resource.TestCheckResourceAttrSet("data.ibm_vpn_gateway.gateway", "private_vlan_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_vpn_gateway.gateway", "members.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpnGatewayDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+data "ibm_vpn_gateway" "gateway" {
+    name = "%s"
+}`, name)
+}
@@ -0,0 +1,134 @@
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	watsonAuthTypeAPIKey = "apikey"
+	watsonAuthTypeBasic  = "basic"
+)
+
+func dataSourceIBMWatsonServiceConfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMWatsonServiceConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Watson service instance name for example, my-assistant",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"space_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The guid of the space in which the instance is present",
+			},
+			"service_key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the service key to read the credentials from. Defaults to the first service key of the instance",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The endpoint of the Watson service",
+			},
+			"auth_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of credentials of the service key, either apikey or basic",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key used to authenticate with the Watson service",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user name used to authenticate with the Watson service",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password used to authenticate with the Watson service",
+			},
+		},
+	}
+}
+
+func dataSourceIBMWatsonServiceConfigRead(d *schema.ResourceData, meta interface{}) error {
+	cfClient, err := meta.(ClientSession).MccpAPI()
+	if err != nil {
+		return err
+	}
+	siAPI := cfClient.ServiceInstances()
+	name := d.Get("name").(string)
+	spaceGUID := d.Get("space_guid").(string)
+	inst, err := siAPI.FindByNameInSpace(spaceGUID, name)
+	if err != nil {
+		return err
+	}
+
+	serviceInstance, err := siAPI.Get(inst.GUID, 1)
+	if err != nil {
+		return fmt.Errorf("Error retrieving service: %s", err)
+	}
+
+	serviceKeys := serviceInstance.Entity.ServiceKeys
+	if len(serviceKeys) == 0 {
+		return fmt.Errorf("Service instance %s doesn't have any service key", name)
+	}
+
+	keyName := d.Get("service_key_name").(string)
+	var credentials map[string]interface{}
+	if keyName == "" {
+		keyName = serviceKeys[0].Entity.Name
+		credentials = serviceKeys[0].Entity.Credentials
+	} else {
+		for _, k := range serviceKeys {
+			if k.Entity.Name == keyName {
+				credentials = k.Entity.Credentials
+				break
+			}
+		}
+		if credentials == nil {
+			return fmt.Errorf("No service key with name %s found for service instance %s", keyName, name)
+		}
+	}
+
+	creds := flattenCredentials(credentials)
+	if creds["url"] == "" {
+		return fmt.Errorf("Service key %s of service instance %s doesn't contain a url", keyName, name)
+	}
+
+	d.SetId(serviceInstance.Metadata.GUID)
+	d.Set("service_key_name", keyName)
+	d.Set("url", creds["url"])
+	d.Set("username", "")
+	d.Set("password", "")
+	d.Set("api_key", "")
+
+	// Newer Watson service keys carry an IAM api key, older ones a user name and password
+	for _, k := range []string{"apikey", "api_key", "iam_apikey"} {
+		if v := creds[k]; v != "" {
+			d.Set("auth_type", watsonAuthTypeAPIKey)
+			d.Set("api_key", v)
+			return nil
+		}
+	}
+	if creds["username"] != "" && creds["password"] != "" {
+		d.Set("auth_type", watsonAuthTypeBasic)
+		d.Set("username", creds["username"])
+		d.Set("password", creds["password"])
+		return nil
+	}
+
+	return fmt.Errorf("Service key %s of service instance %s contains neither an api key nor a user name and password", keyName, name)
+}
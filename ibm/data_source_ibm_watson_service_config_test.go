@@ -0,0 +1,57 @@
+package ibm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMWatsonServiceConfigDataSource_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandInt())
+	serviceKey := fmt.Sprintf("terraform_%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMWatsonServiceConfigDataSourceConfig(serviceName, serviceKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_watson_service_config.testacc_ds_watson", "service_key_name", serviceKey),
+					resource.TestMatchResourceAttr("data.ibm_watson_service_config.testacc_ds_watson", "url", regexp.MustCompile("^https://")),
+					resource.TestMatchResourceAttr("data.ibm_watson_service_config.testacc_ds_watson", "auth_type", regexp.MustCompile("^(apikey|basic)$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMWatsonServiceConfigDataSourceConfig(serviceName, serviceKey string) string {
+	return fmt.Sprintf(`
+data "ibm_space" "spacedata" {
+  org   = "%s"
+  space = "%s"
+}
+
+resource "ibm_service_instance" "service" {
+  name       = "%s"
+  space_guid = "${data.ibm_space.spacedata.id}"
+  service    = "conversation"
+  plan       = "free"
+}
+
+resource "ibm_service_key" "servicekey" {
+  name                  = "%s"
+  service_instance_guid = "${ibm_service_instance.service.id}"
+}
+
+data "ibm_watson_service_config" "testacc_ds_watson" {
+  name             = "${ibm_service_instance.service.name}"
+  space_guid       = "${data.ibm_space.spacedata.id}"
+  service_key_name = "${ibm_service_key.servicekey.name}"
+}`, cfOrganization, cfSpace, serviceName, serviceKey)
+
+}
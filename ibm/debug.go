@@ -0,0 +1,92 @@
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const redactedValue = "[REDACTED]"
+
+var (
+	// secretJSONFieldRegexp matches the JSON string fields which hold credentials, such as password,
+	// rootPassword, apiKey, authenticationKey, access_token or client_secret
+	secretJSONFieldRegexp = regexp.MustCompile(`(?i)("[^"]*(?:password|passwd|api_?key|authenticationkey|token|secret|passcode)[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// secretQueryRegexp matches the credentials sent as form or query parameters
+	secretQueryRegexp = regexp.MustCompile(`(?i)\b((?:password|api_?key|refresh_token|access_token|passcode)=)[^&\s]*`)
+
+	// secretHeaderRegexp matches the HTTP headers which hold credentials
+	secretHeaderRegexp = regexp.MustCompile(`(?im)^((?:authorization|x-auth-token|x-auth-refresh-token|x-auth-uaa-token):[ \t]*)[^\r\n]*`)
+)
+
+// redactSecrets replaces the API keys, tokens and passwords found in the text of an HTTP request
+// or response, so that it can be logged
+func redactSecrets(s string) string {
+	s = secretJSONFieldRegexp.ReplaceAllString(s, `$1"`+redactedValue+`"`)
+	s = secretQueryRegexp.ReplaceAllString(s, "${1}"+redactedValue)
+	s = secretHeaderRegexp.ReplaceAllString(s, "${1}"+redactedValue)
+	return s
+}
+
+// logSoftLayerRequest logs a SoftLayer API call and its result with the credentials redacted
+func logSoftLayerRequest(requestID, service, method string, args []interface{}, options *sl.Options, result interface{}, err error) {
+	var id, mask, filter string
+	if options != nil {
+		if options.Id != nil {
+			id = fmt.Sprintf("%d", *options.Id)
+		}
+		mask = options.Mask
+		filter = options.Filter
+	}
+
+	parameters, _ := json.Marshal(args)
+	log.Printf("[DEBUG] SoftLayer request %s: %s::%s id=%s mask=%s filter=%s parameters=%s",
+		requestID, service, method, id, mask, redactSecrets(filter), redactSecrets(string(parameters)))
+
+	if err != nil {
+		log.Printf("[DEBUG] SoftLayer response %s: %s", requestID, redactSecrets(err.Error()))
+		return
+	}
+	response, _ := json.Marshal(result)
+	log.Printf("[DEBUG] SoftLayer response %s: %s", requestID, redactSecrets(string(response)))
+}
+
+// debugTransport is a http.RoundTripper which logs the requests and responses of the Bluemix
+// clients of a session with the credentials redacted. The trace logger of the Bluemix SDK is
+// global, so the requests are logged by the transport of the session which has debug set instead.
+type debugTransport struct {
+	transport http.RoundTripper
+}
+
+func newDebugTransport(transport http.RoundTripper) *debugTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &debugTransport{transport: transport}
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	showBody := !strings.Contains(req.Header.Get("Content-Type"), "multipart/form-data")
+	if dump, err := httputil.DumpRequestOut(req, showBody); err == nil {
+		log.Printf("[DEBUG] Bluemix request:\n%s", redactSecrets(string(dump)))
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		log.Printf("[DEBUG] Bluemix response: %s", redactSecrets(err.Error()))
+		return resp, err
+	}
+
+	showBody = !strings.Contains(resp.Header.Get("Content-Type"), "application/zip")
+	if dump, err := httputil.DumpResponse(resp, showBody); err == nil {
+		log.Printf("[DEBUG] Bluemix response:\n%s", redactSecrets(string(dump)))
+	}
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package ibm
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			`{"username":"admin","password":"s3cr\"et","apiKey":"abc"}`,
+			`{"username":"admin","password":"[REDACTED]","apiKey":"[REDACTED]"}`,
+		},
+		{
+			`[{"authenticationKey": "abc", "rootPassword": "xyz"}]`,
+			`[{"authenticationKey": "[REDACTED]", "rootPassword": "[REDACTED]"}]`,
+		},
+		{
+			`grant_type=password&password=xyz&apikey=abc&response_type=token`,
+			`grant_type=password&password=[REDACTED]&apikey=[REDACTED]&response_type=token`,
+		},
+		{
+			"GET /v2/apps HTTP/1.1\r\nAuthorization: bearer abc.def\r\nAccept: */*",
+			"GET /v2/apps HTTP/1.1\r\nAuthorization: [REDACTED]\r\nAccept: */*",
+		},
+		{
+			`{"access_token":"abc","token_type":"bearer","expires_in":3600}`,
+			`{"access_token":"[REDACTED]","token_type":"[REDACTED]","expires_in":3600}`,
+		},
+	}
+
+	for _, c := range cases {
+		if actual := redactSecrets(c.input); actual != c.expected {
+			t.Fatalf("expected %q, got %q", c.expected, actual)
+		}
+	}
+}
+
+func TestDebugTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"echo":` + string(body) + `,"access_token":"xyz"}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{Transport: newDebugTransport(nil)}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{"apikey":"abc"}`))
+	req.Header.Set("Authorization", "Bearer abc")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if expected := `{"echo":{"apikey":"abc"},"access_token":"xyz"}`; string(body) != expected {
+		t.Fatalf("expected the response %q, got %q", expected, body)
+	}
+	logged := buf.String()
+	for _, secret := range []string{"Bearer abc", `"abc"`, `"xyz"`} {
+		if strings.Contains(logged, secret) {
+			t.Fatalf("expected %q to be redacted from the log, got %q", secret, logged)
+		}
+	}
+	if !strings.Contains(logged, "Bluemix request") || !strings.Contains(logged, "Bluemix response") {
+		t.Fatalf("expected the request and the response to be logged, got %q", logged)
+	}
+}
@@ -0,0 +1,115 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/satori/go.uuid"
+	slsession "github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// SoftLayerError describes a failed SoftLayer API call: the SoftLayer exception, the HTTP status
+// code and the request which failed. The request ID is generated by the provider and logged with
+// the request, so the failed call can be found in the TF_LOG output.
+type SoftLayerError struct {
+	RequestID  string
+	Service    string
+	Method     string
+	ObjectID   *int
+	StatusCode int
+	Exception  string
+	Message    string
+
+	// Err is the underlying error, when the call failed before SoftLayer returned a response
+	Err error
+}
+
+func (e *SoftLayerError) Error() string {
+	var msg string
+	if e.Exception != "" {
+		msg = e.Exception + ": "
+	}
+	if e.Message != "" {
+		msg = msg + e.Message
+	} else if e.Err != nil {
+		msg = msg + e.Err.Error()
+	}
+
+	request := e.Service + "::" + e.Method
+	if e.ObjectID != nil {
+		request = fmt.Sprintf("%s(id=%d)", request, *e.ObjectID)
+	}
+	details := []string{request, "request ID " + e.RequestID}
+	if e.StatusCode != 0 {
+		details = append([]string{fmt.Sprintf("HTTP %d", e.StatusCode)}, details...)
+	}
+
+	return fmt.Sprintf("%s (%s)", strings.TrimSpace(msg), strings.Join(details, ", "))
+}
+
+// wrapSoftLayerError records the request details in the wrapped error of the sl.Error returned by
+// the SoftLayer API. The result is still a sl.Error, so the status code and exception name are
+// preserved for the resources checking them, while its message identifies the failed request.
+func wrapSoftLayerError(err sl.Error, requestID, service, method string, options *sl.Options) sl.Error {
+	slErr := &SoftLayerError{
+		RequestID:  requestID,
+		Service:    service,
+		Method:     method,
+		StatusCode: err.StatusCode,
+		Exception:  err.Exception,
+		Message:    err.Message,
+		Err:        err.Wrapped,
+	}
+	if options != nil {
+		slErr.ObjectID = options.Id
+	}
+
+	err.Wrapped = slErr
+	return err
+}
+
+// softLayerTransport is a SoftLayer transport handler which identifies every request, records the
+// request in the errors returned by the SoftLayer API, and logs the requests when debug is set
+type softLayerTransport struct {
+	transport slsession.TransportHandler
+	debug     bool
+}
+
+func newSoftLayerTransport(endpointURL string, debug bool, tagger *requestTagger) *softLayerTransport {
+	var transport slsession.TransportHandler = newSoftLayerRestTransport(tagger)
+	if strings.Contains(endpointURL, "/xmlrpc/") {
+		transport = &slsession.XmlRpcTransport{}
+	}
+	return &softLayerTransport{transport: transport, debug: debug}
+}
+
+func (t *softLayerTransport) DoRequest(sess *slsession.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	requestID := uuid.NewV4().String()
+	if !t.debug {
+		log.Printf("[DEBUG] SoftLayer request %s: %s::%s", requestID, service, method)
+	}
+
+	err := t.transport.DoRequest(sess, service, method, args, options, pResult)
+	if slErr, ok := err.(sl.Error); ok {
+		err = wrapSoftLayerError(slErr, requestID, service, method, options)
+	}
+
+	if t.debug {
+		logSoftLayerRequest(requestID, service, method, args, options, pResult, err)
+	}
+	return err
+}
+
+// isSoftLayerNotFound returns whether the SoftLayer object of the failed call does not exist. The
+// xmlrpc endpoint returns a 200 instead of a 404 for the objects which are not found.
+func isSoftLayerNotFound(err error) bool {
+	apiErr, ok := err.(sl.Error)
+	if !ok {
+		return false
+	}
+	return apiErr.StatusCode == 404 ||
+		apiErr.Exception == "SoftLayer_Exception_ObjectNotFound" ||
+		strings.Contains(apiErr.Message, "Unable to find object with id")
+}
@@ -0,0 +1,69 @@
+package ibm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestWrapSoftLayerError(t *testing.T) {
+	apiErr := sl.Error{
+		StatusCode: 500,
+		Exception:  "SoftLayer_Exception_Order_Item_Invalid",
+		Message:    "Invalid price",
+	}
+
+	var err error = wrapSoftLayerError(apiErr, "42", "SoftLayer_Product_Order", "placeOrder", &sl.Options{Id: sl.Int(7)})
+
+	wrapped, ok := err.(sl.Error)
+	if !ok {
+		t.Fatalf("expected a sl.Error, got %T", err)
+	}
+	if wrapped.StatusCode != 500 || wrapped.Exception != "SoftLayer_Exception_Order_Item_Invalid" {
+		t.Fatalf("status code or exception not preserved: %#v", wrapped)
+	}
+
+	expected := "SoftLayer_Exception_Order_Item_Invalid: Invalid price (HTTP 500, SoftLayer_Product_Order::placeOrder(id=7), request ID 42)"
+	if err.Error() != expected {
+		t.Fatalf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestWrapSoftLayerError_networkError(t *testing.T) {
+	apiErr := sl.Error{Wrapped: errors.New("connection refused")}
+
+	err := wrapSoftLayerError(apiErr, "42", "SoftLayer_Account", "getObject", nil)
+
+	expected := "connection refused (SoftLayer_Account::getObject, request ID 42)"
+	if err.Error() != expected {
+		t.Fatalf("expected %q, got %q", expected, err.Error())
+	}
+	if slErr, ok := err.Wrapped.(*SoftLayerError); !ok || slErr.Err == nil {
+		t.Fatalf("underlying error not preserved: %#v", err.Wrapped)
+	}
+}
+
+func TestIsSoftLayerNotFound(t *testing.T) {
+	notFound := []error{
+		sl.Error{StatusCode: 404, Exception: "SoftLayer_Exception_ObjectNotFound"},
+		sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_ObjectNotFound"},
+		sl.Error{StatusCode: 200, Message: "Unable to find object with id of '42'."},
+	}
+	for _, err := range notFound {
+		if !isSoftLayerNotFound(err) {
+			t.Errorf("expected %#v to be a not found error", err)
+		}
+	}
+
+	found := []error{
+		nil,
+		errors.New("not found"),
+		sl.Error{StatusCode: 500, Exception: "SoftLayer_Exception_Public", Message: "Internal error"},
+	}
+	for _, err := range found {
+		if isSoftLayerNotFound(err) {
+			t.Errorf("expected %#v not to be a not found error", err)
+		}
+	}
+}
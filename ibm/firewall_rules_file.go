@@ -0,0 +1,214 @@
+package ibm
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// firewallRuleFields are the attributes of a firewall rule, in the column order used by the CSV format.
+var firewallRuleFields = []string{
+	"action",
+	"src_ip_address",
+	"src_ip_cidr",
+	"dst_ip_address",
+	"dst_ip_cidr",
+	"dst_port_range_start",
+	"dst_port_range_end",
+	"protocol",
+	"notes",
+}
+
+var firewallRuleIntFields = map[string]bool{
+	"src_ip_cidr":          true,
+	"dst_ip_cidr":          true,
+	"dst_port_range_start": true,
+	"dst_port_range_end":   true,
+}
+
+// readFirewallRulesFile reads firewall rules from a JSON or CSV file. The format is chosen from the
+// file extension. A JSON file holds an array of rule objects, a CSV file holds a header row naming
+// the rule attributes followed by one rule per row. Rules are returned in the same form as the
+// rules attribute of the ibm_firewall_policy resource.
+func readFirewallRulesFile(path string) ([]interface{}, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading firewall rules file %s: %s", path, err)
+	}
+
+	var records []map[string]string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		records, err = parseFirewallRulesJSON(content)
+	case ".csv":
+		records, err = parseFirewallRulesCSV(content)
+	default:
+		return nil, fmt.Errorf("Unsupported firewall rules file %s: the file extension must be .json or .csv", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing firewall rules file %s: %s", path, err)
+	}
+
+	rules := make([]interface{}, 0, len(records))
+	for i, record := range records {
+		rule, err := firewallRuleFromRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing rule %d of firewall rules file %s: %s", i+1, path, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func parseFirewallRulesJSON(content []byte) ([]map[string]string, error) {
+	var raw []map[string]interface{}
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil, err
+	}
+
+	records := make([]map[string]string, 0, len(raw))
+	for _, r := range raw {
+		record := make(map[string]string, len(r))
+		for k, v := range r {
+			switch v := v.(type) {
+			case nil:
+			case string:
+				record[k] = v
+			case float64:
+				record[k] = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				return nil, fmt.Errorf("Invalid value for %s: %v", k, v)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func parseFirewallRulesCSV(content []byte) ([]map[string]string, error) {
+	rows, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []map[string]string{}, nil
+	}
+
+	header := rows[0]
+	records := make([]map[string]string, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		record := make(map[string]string, len(header))
+		for i, column := range header {
+			record[strings.TrimSpace(column)] = strings.TrimSpace(row[i])
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func firewallRuleFromRecord(record map[string]string) (map[string]interface{}, error) {
+	known := make(map[string]bool, len(firewallRuleFields))
+	for _, field := range firewallRuleFields {
+		known[field] = true
+	}
+	for k := range record {
+		if !known[k] {
+			return nil, fmt.Errorf("Unknown attribute %s", k)
+		}
+	}
+
+	rule := map[string]interface{}{"notes": record["notes"]}
+	for _, field := range firewallRuleFields {
+		value := record[field]
+		if field == "notes" {
+			continue
+		}
+		if value == "" {
+			if field == "dst_port_range_start" || field == "dst_port_range_end" {
+				continue
+			}
+			return nil, fmt.Errorf("%s is required", field)
+		}
+		if firewallRuleIntFields[field] {
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be an integer: %s", field, err)
+			}
+			rule[field] = i
+			continue
+		}
+		rule[field] = value
+	}
+	return rule, nil
+}
+
+// formatFirewallRulesJSON renders rules, as stored in the rules attribute, in the JSON format
+// accepted by readFirewallRulesFile.
+func formatFirewallRulesJSON(rules []map[string]interface{}) (string, error) {
+	out, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// formatFirewallRulesCSV renders rules, as stored in the rules attribute, in the CSV format
+// accepted by readFirewallRulesFile.
+func formatFirewallRulesCSV(rules []map[string]interface{}) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(firewallRuleFields); err != nil {
+		return "", err
+	}
+	for _, rule := range rules {
+		row := make([]string, len(firewallRuleFields))
+		for i, field := range firewallRuleFields {
+			if v, ok := rule[field]; ok {
+				row[i] = fmt.Sprintf("%v", v)
+			}
+		}
+		if err := w.Write(row); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+// firewallRuleKey returns a normalized representation of a rule, used to detect whether the rules
+// on a device still match the rules of a rules file.
+func firewallRuleKey(rule map[string]interface{}) string {
+	key := make([]string, len(firewallRuleFields))
+	for i, field := range firewallRuleFields {
+		v, ok := rule[field]
+		if !ok || v == nil {
+			continue
+		}
+		s := fmt.Sprintf("%v", v)
+		if field == "src_ip_address" || field == "dst_ip_address" {
+			if ip := net.ParseIP(s); ip != nil {
+				s = ip.String()
+			}
+		}
+		if firewallRuleIntFields[field] && s == "0" && strings.HasPrefix(field, "dst_port") {
+			s = ""
+		}
+		key[i] = strings.ToLower(s)
+	}
+	return strings.Join(key, "|")
+}
+
+// firewallRulesHash returns the hash of the rules, in their order. Rules which only differ in the
+// formatting of their values have the same hash.
+func firewallRulesHash(rules []interface{}) string {
+	hash := sha1.Sum([]byte(strings.Join(firewallRuleKeys(rules), "\n")))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,79 @@
+package ibm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadFirewallRulesFile(t *testing.T) {
+	expected := []interface{}{
+		map[string]interface{}{
+			"action":               "deny",
+			"src_ip_address":       "0.0.0.0",
+			"src_ip_cidr":          0,
+			"dst_ip_address":       "any",
+			"dst_ip_cidr":          32,
+			"dst_port_range_start": 1,
+			"dst_port_range_end":   65535,
+			"protocol":             "tcp",
+			"notes":                "Deny all",
+		},
+		map[string]interface{}{
+			"action":               "permit",
+			"src_ip_address":       "0.0.0.0",
+			"src_ip_cidr":          0,
+			"dst_ip_address":       "any",
+			"dst_ip_cidr":          32,
+			"dst_port_range_start": 22,
+			"dst_port_range_end":   22,
+			"protocol":             "tcp",
+			"notes":                "Allow SSH",
+		},
+	}
+
+	for _, path := range []string{"test-fixtures/firewall_rules.json", "test-fixtures/firewall_rules.csv"} {
+		rules, err := readFirewallRulesFile(path)
+		if err != nil {
+			t.Fatalf("%s: err: %s", path, err)
+		}
+		if !reflect.DeepEqual(rules, expected) {
+			t.Fatalf("%s: unexpected rules: %#v", path, rules)
+		}
+	}
+}
+
+func TestReadFirewallRulesFile_unsupportedFormat(t *testing.T) {
+	if _, err := readFirewallRulesFile("test-fixtures/app1.zip"); err == nil {
+		t.Fatal("expected an error for an unsupported file format")
+	}
+}
+
+func TestFormatFirewallRulesCSV_roundTrip(t *testing.T) {
+	rules, err := readFirewallRulesFile("test-fixtures/firewall_rules.csv")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	flattened := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		flattened[i] = rule.(map[string]interface{})
+	}
+	out, err := formatFirewallRulesCSV(flattened)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	parsed, err := parseFirewallRulesCSV([]byte(out))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for i, record := range parsed {
+		rule, err := firewallRuleFromRecord(record)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if firewallRuleKey(rule) != firewallRuleKey(flattened[i]) {
+			t.Fatalf("rule %d changed after export: %#v", i, rule)
+		}
+	}
+}
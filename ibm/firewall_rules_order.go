@@ -0,0 +1,267 @@
+package ibm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tfconfig "github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// validateFirewallRulesOrder checks the order_value of the rules. The order values are either set
+// on all the rules or on none of them, and must be unique and increasing in the order the rules are
+// listed. Gaps are allowed so that a rule can be inserted without renumbering the others, the rules
+// are numbered contiguously on the device.
+func validateFirewallRulesOrder(ruleList []interface{}) error {
+	previous := 0
+	seen := make(map[int]int, len(ruleList))
+	for i, ruleItem := range ruleList {
+		orderValue, _ := ruleItem.(map[string]interface{})["order_value"].(int)
+		if orderValue == 0 {
+			if previous != 0 {
+				return fmt.Errorf("order_value must be set on all the rules or on none of them: rule %d has no order_value", i+1)
+			}
+			continue
+		}
+		if i > 0 && previous == 0 {
+			return fmt.Errorf("order_value must be set on all the rules or on none of them: rule 1 has no order_value")
+		}
+		if j, ok := seen[orderValue]; ok {
+			return fmt.Errorf("order_value %d of rule %d is already used by rule %d", orderValue, i+1, j)
+		}
+		if orderValue < previous {
+			return fmt.Errorf("Rules must be listed by increasing order_value: rule %d has order_value %d, after order_value %d",
+				i+1, orderValue, previous)
+		}
+		seen[orderValue] = i + 1
+		previous = orderValue
+	}
+	return nil
+}
+
+// keepFirewallRulesOrderValues sets the configured order_value on the rules read from the device,
+// which numbers them contiguously. The rules are matched by their fields, so that the order values
+// follow the rules which were moved outside of Terraform.
+func keepFirewallRulesOrderValues(rules []map[string]interface{}, configured []interface{}) {
+	orderValues := make(map[string][]int, len(configured))
+	for _, r := range configured {
+		rule := r.(map[string]interface{})
+		if orderValue, ok := rule["order_value"].(int); ok && orderValue != 0 {
+			key := firewallRuleKey(rule)
+			orderValues[key] = append(orderValues[key], orderValue)
+		}
+	}
+	for _, rule := range rules {
+		key := firewallRuleKey(rule)
+		if values := orderValues[key]; len(values) > 0 {
+			rule["order_value"] = values[0]
+			orderValues[key] = values[1:]
+		}
+	}
+}
+
+// checkFirewallPolicyPlan validates the order values of the planned rules, and adds the rules which
+// are added, removed and moved to rule_changes in the diff, so that they are shown in the plan
+func checkFirewallPolicyPlan(sess ClientSession, info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) error {
+	changed := false
+	for k := range d.Attributes {
+		if strings.HasPrefix(k, "rules.") {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	newRules, ok := readFirewallPolicyRules(s.MergeDiff(d).Attributes)
+	if !ok {
+		return nil
+	}
+	if err := validateFirewallRulesOrder(newRules); err != nil {
+		return fmt.Errorf("%s: %s", info.HumanId(), err)
+	}
+
+	if s == nil || s.ID == "" {
+		return nil
+	}
+	oldRules, ok := readFirewallPolicyRules(s.Attributes)
+	if !ok {
+		return nil
+	}
+	if changes := describeFirewallRuleChanges(oldRules, newRules); len(changes) > 0 {
+		setFirewallRuleChangesDiff(d, s.Attributes, changes)
+	}
+	return nil
+}
+
+// diffFirewallRulesFile applies rules_file again when the rules on the firewall, whose hash is
+// read in rules_file_hash, no longer match the rules of the file, or when the file changes
+func diffFirewallRulesFile(info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) (*terraform.InstanceDiff, error) {
+	if s == nil || s.ID == "" || d != nil && (d.Destroy || d.RequiresNew()) {
+		return d, nil
+	}
+	attributes := s.Attributes
+	if d != nil {
+		attributes = s.MergeDiff(d).Attributes
+	}
+	path := attributes["rules_file"]
+	if path == "" || path == tfconfig.UnknownVariableValue {
+		return d, nil
+	}
+
+	rules, err := readFirewallRulesFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", info.HumanId(), err)
+	}
+	hash := firewallRulesHash(rules)
+	if hash == s.Attributes["rules_file_hash"] {
+		return d, nil
+	}
+
+	if d == nil {
+		d = &terraform.InstanceDiff{}
+	}
+	if d.Attributes == nil {
+		d.Attributes = map[string]*terraform.ResourceAttrDiff{}
+	}
+	d.Attributes["rules_file_hash"] = &terraform.ResourceAttrDiff{
+		Old: s.Attributes["rules_file_hash"],
+		New: hash,
+	}
+	return d, nil
+}
+
+// readFirewallPolicyRules reads the rules from the flattened attributes of a firewall policy. The
+// rules are not read when some of their values are not known yet.
+func readFirewallPolicyRules(attributes map[string]string) ([]interface{}, bool) {
+	for k, v := range attributes {
+		if strings.HasPrefix(k, "rules.") && v == tfconfig.UnknownVariableValue {
+			return nil, false
+		}
+	}
+	reader := &schema.MapFieldReader{
+		Map:    schema.BasicMapReader(attributes),
+		Schema: resourceIBMFirewallPolicy().Schema,
+	}
+	result, err := reader.ReadField([]string{"rules"})
+	if err != nil || !result.Exists {
+		return nil, false
+	}
+	rules, ok := result.Value.([]interface{})
+	return rules, ok
+}
+
+// setFirewallRuleChangesDiff sets the rule changes as the new value of rule_changes in the diff
+func setFirewallRuleChangesDiff(d *terraform.InstanceDiff, attributes map[string]string, changes []string) {
+	oldCount, _ := strconv.Atoi(attributes["rule_changes.#"])
+	d.Attributes["rule_changes.#"] = &terraform.ResourceAttrDiff{
+		Old: attributes["rule_changes.#"],
+		New: strconv.Itoa(len(changes)),
+	}
+	for i, change := range changes {
+		k := fmt.Sprintf("rule_changes.%d", i)
+		d.Attributes[k] = &terraform.ResourceAttrDiff{Old: attributes[k], New: change}
+	}
+	for i := len(changes); i < oldCount; i++ {
+		k := fmt.Sprintf("rule_changes.%d", i)
+		d.Attributes[k] = &terraform.ResourceAttrDiff{Old: attributes[k], NewRemoved: true}
+	}
+}
+
+// describeFirewallRuleChanges summarizes the differences between two lists of rules as the rules
+// which were added, removed or moved. Rules which only shift because other rules were added or
+// removed before them are not reported.
+func describeFirewallRuleChanges(oldRules, newRules []interface{}) []string {
+	oldKeys := firewallRuleKeys(oldRules)
+	newKeys := firewallRuleKeys(newRules)
+
+	// The rules of the longest common subsequence keep their relative order
+	lcs := make([][]int, len(oldKeys)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newKeys)+1)
+	}
+	for i := len(oldKeys) - 1; i >= 0; i-- {
+		for j := len(newKeys) - 1; j >= 0; j-- {
+			if oldKeys[i] == newKeys[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	keptOld := make(map[int]bool)
+	keptNew := make(map[int]bool)
+	for i, j := 0, 0; i < len(oldKeys) && j < len(newKeys); {
+		if oldKeys[i] == newKeys[j] {
+			keptOld[i] = true
+			keptNew[j] = true
+			i++
+			j++
+		} else if lcs[i+1][j] >= lcs[i][j+1] {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	// The other rules are moved when they are in both lists, added or removed otherwise
+	remaining := make(map[string][]int)
+	for i, key := range oldKeys {
+		if !keptOld[i] {
+			remaining[key] = append(remaining[key], i)
+		}
+	}
+	changes := make([]string, 0)
+	for j, key := range newKeys {
+		if keptNew[j] {
+			continue
+		}
+		summary := firewallRuleSummary(newRules[j].(map[string]interface{}))
+		if indexes := remaining[key]; len(indexes) > 0 {
+			remaining[key] = indexes[1:]
+			changes = append(changes, fmt.Sprintf("moved rule %s from position %d to %d", summary, indexes[0]+1, j+1))
+		} else {
+			changes = append(changes, fmt.Sprintf("added rule %s at position %d", summary, j+1))
+		}
+	}
+	removed := make(map[int]bool)
+	for _, indexes := range remaining {
+		for _, i := range indexes {
+			removed[i] = true
+		}
+	}
+	for i := range oldKeys {
+		if removed[i] {
+			changes = append(changes, fmt.Sprintf("removed rule %s from position %d",
+				firewallRuleSummary(oldRules[i].(map[string]interface{})), i+1))
+		}
+	}
+	return changes
+}
+
+func firewallRuleKeys(rules []interface{}) []string {
+	keys := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		keys = append(keys, firewallRuleKey(rule.(map[string]interface{})))
+	}
+	return keys
+}
+
+// firewallRuleSummary returns a one line description of a rule, such as
+// "permit tcp 0.0.0.0/0 -> any/32:22-22 (Allow SSH)"
+func firewallRuleSummary(rule map[string]interface{}) string {
+	summary := fmt.Sprintf("%v %v %v/%v -> %v/%v", rule["action"], rule["protocol"],
+		rule["src_ip_address"], rule["src_ip_cidr"], rule["dst_ip_address"], rule["dst_ip_cidr"])
+	if start, ok := rule["dst_port_range_start"].(int); ok && start != 0 {
+		summary += fmt.Sprintf(":%d-%v", start, rule["dst_port_range_end"])
+	}
+	if notes, ok := rule["notes"].(string); ok && notes != "" {
+		summary += fmt.Sprintf(" (%s)", notes)
+	}
+	return summary
+}
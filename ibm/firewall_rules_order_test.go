@@ -0,0 +1,202 @@
+package ibm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/flatmap"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testFirewallRule(port, orderValue int, notes string) map[string]interface{} {
+	rule := map[string]interface{}{
+		"action":               "permit",
+		"src_ip_address":       "0.0.0.0",
+		"src_ip_cidr":          0,
+		"dst_ip_address":       "any",
+		"dst_ip_cidr":          32,
+		"dst_port_range_start": port,
+		"dst_port_range_end":   port,
+		"protocol":             "tcp",
+		"notes":                notes,
+	}
+	if orderValue != 0 {
+		rule["order_value"] = orderValue
+	}
+	return rule
+}
+
+func TestValidateFirewallRulesOrder(t *testing.T) {
+	cases := []struct {
+		orderValues []int
+		err         string
+	}{
+		{[]int{0, 0, 0}, ""},
+		{[]int{1, 2, 3}, ""},
+		{[]int{10, 15, 20}, ""},
+		{[]int{10, 0, 20}, "rule 2 has no order_value"},
+		{[]int{0, 10, 20}, "rule 1 has no order_value"},
+		{[]int{10, 20, 10}, "order_value 10 of rule 3 is already used by rule 1"},
+		{[]int{10, 30, 20}, "rule 3 has order_value 20, after order_value 30"},
+	}
+	for _, c := range cases {
+		ruleList := make([]interface{}, 0, len(c.orderValues))
+		for i, orderValue := range c.orderValues {
+			ruleList = append(ruleList, testFirewallRule(i+1, orderValue, ""))
+		}
+		err := validateFirewallRulesOrder(ruleList)
+		if c.err == "" && err != nil {
+			t.Errorf("%v: unexpected error: %s", c.orderValues, err)
+		}
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%v: expected error containing %q, got %v", c.orderValues, c.err, err)
+		}
+	}
+}
+
+func TestDescribeFirewallRuleChanges(t *testing.T) {
+	ssh := testFirewallRule(22, 0, "Allow SSH")
+	http := testFirewallRule(80, 0, "Allow HTTP")
+	https := testFirewallRule(443, 0, "Allow HTTPS")
+	dns := testFirewallRule(53, 0, "Allow DNS")
+
+	// Inserting a rule only reports the new rule, not the rules after it
+	changes := describeFirewallRuleChanges(
+		[]interface{}{ssh, http, https},
+		[]interface{}{dns, ssh, http, https})
+	expected := []string{
+		"added rule permit tcp 0.0.0.0/0 -> any/32:53-53 (Allow DNS) at position 1",
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected %v, got %v", expected, changes)
+	}
+
+	changes = describeFirewallRuleChanges(
+		[]interface{}{ssh, http, dns, https},
+		[]interface{}{https, ssh, dns})
+	expected = []string{
+		"moved rule permit tcp 0.0.0.0/0 -> any/32:443-443 (Allow HTTPS) from position 4 to 1",
+		"removed rule permit tcp 0.0.0.0/0 -> any/32:80-80 (Allow HTTP) from position 2",
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected %v, got %v", expected, changes)
+	}
+
+	// Order values are not part of the rule
+	changes = describeFirewallRuleChanges(
+		[]interface{}{ssh, http},
+		[]interface{}{testFirewallRule(22, 10, "Allow SSH"), testFirewallRule(80, 20, "Allow HTTP")})
+	if len(changes) != 0 {
+		t.Errorf("Expected no changes, got %v", changes)
+	}
+}
+
+func TestKeepFirewallRulesOrderValues(t *testing.T) {
+	// The device numbers the rules contiguously, and the HTTP rule was moved before the SSH rule
+	rules := []map[string]interface{}{
+		testFirewallRule(80, 1, "Allow HTTP"),
+		testFirewallRule(22, 2, "Allow SSH"),
+		testFirewallRule(443, 3, "Allow HTTPS"),
+	}
+	configured := []interface{}{
+		testFirewallRule(22, 10, "Allow SSH"),
+		testFirewallRule(80, 20, "Allow HTTP"),
+	}
+	keepFirewallRulesOrderValues(rules, configured)
+
+	for i, expected := range []int{20, 10, 3} {
+		if rules[i]["order_value"] != expected {
+			t.Errorf("Expected order_value %d for rule %d, got %v", expected, i+1, rules[i]["order_value"])
+		}
+	}
+}
+
+func testFirewallPolicyDiff(oldRules, newRules []interface{}) (*terraform.InstanceState, *terraform.InstanceDiff) {
+	oldAttributes := flatmap.Flatten(map[string]interface{}{"rules": oldRules})
+	newAttributes := flatmap.Flatten(map[string]interface{}{"rules": newRules})
+
+	d := &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{}}
+	for k, v := range newAttributes {
+		if oldAttributes[k] != v {
+			d.Attributes[k] = &terraform.ResourceAttrDiff{Old: oldAttributes[k], New: v}
+		}
+	}
+	for k, v := range oldAttributes {
+		if _, ok := newAttributes[k]; !ok {
+			d.Attributes[k] = &terraform.ResourceAttrDiff{Old: v, NewRemoved: true}
+		}
+	}
+	return &terraform.InstanceState{ID: "1234", Attributes: oldAttributes}, d
+}
+
+func TestCheckFirewallPolicyPlan(t *testing.T) {
+	info := &terraform.InstanceInfo{Id: "ibm_firewall_policy.rules", Type: "ibm_firewall_policy"}
+	ssh := testFirewallRule(22, 10, "Allow SSH")
+	http := testFirewallRule(80, 20, "Allow HTTP")
+
+	s, d := testFirewallPolicyDiff([]interface{}{ssh, http}, []interface{}{ssh, testFirewallRule(80, 5, "Allow HTTP")})
+	err := checkFirewallPolicyPlan(nil, info, s, d)
+	if err == nil || !strings.Contains(err.Error(), "rule 2 has order_value 5, after order_value 10") {
+		t.Errorf("Expected the order values to be invalid, got %v", err)
+	}
+
+	s, d = testFirewallPolicyDiff([]interface{}{ssh, http},
+		[]interface{}{testFirewallRule(80, 10, "Allow HTTP"), testFirewallRule(22, 20, "Allow SSH")})
+	s.Attributes["rule_changes.#"] = "2"
+	s.Attributes["rule_changes.0"] = "added rule"
+	s.Attributes["rule_changes.1"] = "removed rule"
+	if err := checkFirewallPolicyPlan(nil, info, s, d); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if attr := d.Attributes["rule_changes.#"]; attr == nil || attr.New != "1" {
+		t.Fatalf("Expected one rule change in the diff, got %v", attr)
+	}
+	if attr := d.Attributes["rule_changes.0"]; !strings.HasPrefix(attr.New, "moved rule") {
+		t.Errorf("Expected a moved rule, got %q", attr.New)
+	}
+	if attr := d.Attributes["rule_changes.1"]; attr == nil || !attr.NewRemoved {
+		t.Errorf("Expected the previous rule change to be removed, got %v", attr)
+	}
+
+	// Only the attributes of the rules are checked
+	s, d = testFirewallPolicyDiff([]interface{}{ssh, http}, []interface{}{ssh, http})
+	d.Attributes["tags.#"] = &terraform.ResourceAttrDiff{Old: "0", New: "1"}
+	if err := checkFirewallPolicyPlan(nil, info, s, d); err != nil || d.Attributes["rule_changes.#"] != nil {
+		t.Errorf("Expected no rule changes, got %v %v", err, d.Attributes["rule_changes.#"])
+	}
+}
+
+func TestDiffFirewallRulesFile(t *testing.T) {
+	info := &terraform.InstanceInfo{Id: "ibm_firewall_policy.rules", Type: "ibm_firewall_policy"}
+	rules, err := readFirewallRulesFile("test-fixtures/firewall_rules.csv")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	s := &terraform.InstanceState{ID: "123", Attributes: map[string]string{
+		"rules_file":      "test-fixtures/firewall_rules.csv",
+		"rules_file_hash": firewallRulesHash(rules),
+	}}
+
+	d, err := diffFirewallRulesFile(info, s, nil)
+	if err != nil || d != nil {
+		t.Errorf("Expected no diff when the firewall rules match the file, got %v %v", d, err)
+	}
+
+	s.Attributes["rules_file_hash"] = firewallRulesHash(rules[1:])
+	d, err = diffFirewallRulesFile(info, s, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if attr := d.Attributes["rules_file_hash"]; attr == nil || attr.New != firewallRulesHash(rules) {
+		t.Errorf("Expected the file to be applied again, got %v", attr)
+	}
+	if s.Attributes["rules_file"] != "test-fixtures/firewall_rules.csv" {
+		t.Errorf("Expected rules_file to be kept, got %q", s.Attributes["rules_file"])
+	}
+
+	s.Attributes["rules_file"] = "test-fixtures/missing.csv"
+	if _, err := diffFirewallRulesFile(info, s, nil); err == nil || !strings.Contains(err.Error(), "ibm_firewall_policy.rules") {
+		t.Errorf("Expected an error naming the resource, got %v", err)
+	}
+}
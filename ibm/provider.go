@@ -9,7 +9,7 @@ import (
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -47,71 +47,148 @@ func Provider() terraform.ResourceProvider {
 				Description: "The timeout (in seconds) to set for any SoftLayer API calls made.",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"SL_TIMEOUT", "SOFTLAYER_TIMEOUT"}, 60),
 			},
+			"max_concurrent_orders": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum number of SoftLayer orders placed concurrently. 0 means no limit.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"SL_MAX_CONCURRENT_ORDERS", "SOFTLAYER_MAX_CONCURRENT_ORDERS"}, 0),
+			},
+			"dry_run_quote": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only price the SoftLayer orders instead of placing them. The resources which don't support quotes fail to be created.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"SL_DRY_RUN_QUOTE", "SOFTLAYER_DRY_RUN_QUOTE"}, false),
+			},
+			"skip_detailed_refresh": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip the attributes which are expensive to read when refreshing existing resources.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"SL_SKIP_DETAILED_REFRESH", "SOFTLAYER_SKIP_DETAILED_REFRESH"}, false),
+			},
+			"check_account_limits": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check the hourly instance and server limits of the account when the resources are planned.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"SL_CHECK_ACCOUNT_LIMITS", "SOFTLAYER_CHECK_ACCOUNT_LIMITS"}, false),
+			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Log the HTTP requests and responses of the API calls, with the API keys, tokens and passwords redacted.",
+				DefaultFunc: schema.EnvDefaultFunc("IBM_DEBUG", false),
+			},
+			"correlation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An ID added to the User-Agent of the API calls, to identify the Terraform run.",
+				DefaultFunc: schema.EnvDefaultFunc("IBM_CORRELATION_ID", ""),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"ibm_account":                  dataSourceIBMAccount(),
-			"ibm_app":                      dataSourceIBMApp(),
-			"ibm_app_domain_private":       dataSourceIBMAppDomainPrivate(),
-			"ibm_app_domain_shared":        dataSourceIBMAppDomainShared(),
-			"ibm_app_route":                dataSourceIBMAppRoute(),
-			"ibm_compute_image_template":   dataSourceIBMComputeImageTemplate(),
-			"ibm_compute_ssh_key":          dataSourceIBMComputeSSHKey(),
-			"ibm_compute_vm_instance":      dataSourceIBMComputeVmInstance(),
-			"ibm_container_cluster":        dataSourceIBMContainerCluster(),
-			"ibm_container_cluster_config": dataSourceIBMContainerClusterConfig(),
-			"ibm_container_cluster_worker": dataSourceIBMContainerClusterWorker(),
-			"ibm_dns_domain":               dataSourceIBMDNSDomain(),
-			"ibm_iam_user_policy":          dataSourceIBMIAMUserPolicy(),
-			"ibm_network_vlan":             dataSourceIBMNetworkVlan(),
-			"ibm_org":                      dataSourceIBMOrg(),
-			"ibm_service_instance":         dataSourceIBMServiceInstance(),
-			"ibm_service_key":              dataSourceIBMServiceKey(),
-			"ibm_service_plan":             dataSourceIBMServicePlan(),
-			"ibm_space":                    dataSourceIBMSpace(),
+			"ibm_account":                    dataSourceIBMAccount(),
+			"ibm_account_limits":             dataSourceIBMAccountLimits(),
+			"ibm_account_resources":          dataSourceIBMAccountResources(),
+			"ibm_app":                        dataSourceIBMApp(),
+			"ibm_app_domain_private":         dataSourceIBMAppDomainPrivate(),
+			"ibm_app_domain_shared":          dataSourceIBMAppDomainShared(),
+			"ibm_app_route":                  dataSourceIBMAppRoute(),
+			"ibm_billing_usage":              dataSourceIBMBillingUsage(),
+			"ibm_compute_bare_metal":         dataSourceIBMComputeBareMetal(),
+			"ibm_compute_dedicated_hosts":    dataSourceIBMComputeDedicatedHosts(),
+			"ibm_compute_image_template":     dataSourceIBMComputeImageTemplate(),
+			"ibm_compute_ssh_key":            dataSourceIBMComputeSSHKey(),
+			"ibm_compute_transactions":       dataSourceIBMComputeTransactions(),
+			"ibm_compute_vm_instance":        dataSourceIBMComputeVmInstance(),
+			"ibm_container_cluster":          dataSourceIBMContainerCluster(),
+			"ibm_container_cluster_config":   dataSourceIBMContainerClusterConfig(),
+			"ibm_container_cluster_worker":   dataSourceIBMContainerClusterWorker(),
+			"ibm_dns_domain":                 dataSourceIBMDNSDomain(),
+			"ibm_dns_domain_registration":    dataSourceIBMDNSDomainRegistration(),
+			"ibm_firewall_policy":            dataSourceIBMFirewallPolicy(),
+			"ibm_hardware_firewall_shared":   dataSourceIBMHardwareFirewallShared(),
+			"ibm_iam_token":                  dataSourceIBMIAMToken(),
+			"ibm_iam_user_policy":            dataSourceIBMIAMUserPolicy(),
+			"ibm_network_vlan":               dataSourceIBMNetworkVlan(),
+			"ibm_network_vlan_details":       dataSourceIBMNetworkVlanDetails(),
+			"ibm_network_vlan_firewalls":     dataSourceIBMNetworkVlanFirewalls(),
+			"ibm_network_vlan_placement":     dataSourceIBMNetworkVlanPlacement(),
+			"ibm_object_storage_credentials": dataSourceIBMObjectStorageCredentials(),
+			"ibm_org":                        dataSourceIBMOrg(),
+			"ibm_product_price":              dataSourceIBMProductPrice(),
+			"ibm_service_instance":           dataSourceIBMServiceInstance(),
+			"ibm_service_key":                dataSourceIBMServiceKey(),
+			"ibm_service_plan":               dataSourceIBMServicePlan(),
+			"ibm_space":                      dataSourceIBMSpace(),
+			"ibm_space_roles":                dataSourceIBMSpaceRoles(),
+			"ibm_ssl_vpn":                    dataSourceIBMSslVpn(),
+			"ibm_subnet":                     dataSourceIBMSubnet(),
+			"ibm_tags":                       dataSourceIBMTags(),
+			"ibm_vpn_gateway":                dataSourceIBMVpnGateway(),
+			"ibm_watson_service_config":      dataSourceIBMWatsonServiceConfig(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 
-			"ibm_app":                       resourceIBMApp(),
-			"ibm_app_domain_private":        resourceIBMAppDomainPrivate(),
-			"ibm_app_domain_shared":         resourceIBMAppDomainShared(),
-			"ibm_app_route":                 resourceIBMAppRoute(),
-			"ibm_compute_autoscale_group":   resourceIBMComputeAutoScaleGroup(),
-			"ibm_compute_autoscale_policy":  resourceIBMComputeAutoScalePolicy(),
-			"ibm_compute_bare_metal":        resourceIBMComputeBareMetal(),
-			"ibm_compute_monitor":           resourceIBMComputeMonitor(),
-			"ibm_compute_provisioning_hook": resourceIBMComputeProvisioningHook(),
-			"ibm_compute_ssh_key":           resourceIBMComputeSSHKey(),
-			"ibm_compute_ssl_certificate":   resourceIBMComputeSSLCertificate(),
-			"ibm_compute_user":              resourceIBMComputeUser(),
-			"ibm_compute_vm_instance":       resourceIBMComputeVmInstance(),
-			"ibm_container_cluster":         resourceIBMContainerCluster(),
-			"ibm_container_bind_service":    resourceIBMContainerBindService(),
-			"ibm_dns_domain":                resourceIBMDNSDomain(),
-			"ibm_dns_record":                resourceIBMDNSRecord(),
-			"ibm_firewall":                  resourceIBMFirewall(),
-			"ibm_firewall_policy":           resourceIBMFirewallPolicy(),
-			"ibm_iam_user_policy":           resourceIBMIAMUserPolicy(),
-			"ibm_lb":                        resourceIBMLb(),
-			"ibm_lb_service":                resourceIBMLbService(),
-			"ibm_lb_service_group":          resourceIBMLbServiceGroup(),
-			"ibm_lb_vpx":                    resourceIBMLbVpx(),
-			"ibm_lb_vpx_ha":                 resourceIBMLbVpxHa(),
-			"ibm_lb_vpx_service":            resourceIBMLbVpxService(),
-			"ibm_lb_vpx_vip":                resourceIBMLbVpxVip(),
-			"ibm_network_public_ip":         resourceIBMNetworkPublicIp(),
-			"ibm_network_vlan":              resourceIBMNetworkVlan(),
-			"ibm_object_storage_account":    resourceIBMObjectStorageAccount(),
-			"ibm_service_instance":          resourceIBMServiceInstance(),
-			"ibm_service_key":               resourceIBMServiceKey(),
-			"ibm_space":                     resourceIBMSpace(),
-			"ibm_storage_block":             resourceIBMStorageBlock(),
-			"ibm_storage_file":              resourceIBMStorageFile(),
+			"ibm_app":                                 resourceIBMApp(),
+			"ibm_app_domain_private":                  resourceIBMAppDomainPrivate(),
+			"ibm_app_domain_shared":                   resourceIBMAppDomainShared(),
+			"ibm_app_route":                           resourceIBMAppRoute(),
+			"ibm_cloudant_database":                   resourceIBMCloudantDatabase(),
+			"ibm_cloudant_index":                      resourceIBMCloudantIndex(),
+			"ibm_cloudant_replication":                resourceIBMCloudantReplication(),
+			"ibm_compute_autoscale_group":             resourceIBMComputeAutoScaleGroup(),
+			"ibm_compute_autoscale_policy":            resourceIBMComputeAutoScalePolicy(),
+			"ibm_compute_bare_metal":                  resourceIBMComputeBareMetal(),
+			"ibm_compute_monitor":                     resourceIBMComputeMonitor(),
+			"ibm_compute_monitor_notification":        resourceIBMComputeMonitorNotification(),
+			"ibm_compute_provisioning_hook":           resourceIBMComputeProvisioningHook(),
+			"ibm_compute_ssh_key":                     resourceIBMComputeSSHKey(),
+			"ibm_compute_ssl_certificate":             resourceIBMComputeSSLCertificate(),
+			"ibm_compute_user":                        resourceIBMComputeUser(),
+			"ibm_compute_vm_instance":                 resourceIBMComputeVmInstance(),
+			"ibm_container_cluster":                   resourceIBMContainerCluster(),
+			"ibm_container_bind_service":              resourceIBMContainerBindService(),
+			"ibm_container_subnet":                    resourceIBMContainerSubnet(),
+			"ibm_container_worker":                    resourceIBMContainerWorker(),
+			"ibm_dns_domain":                          resourceIBMDNSDomain(),
+			"ibm_dns_domain_registration_nameservers": resourceIBMDNSDomainRegistrationNameservers(),
+			"ibm_dns_record":                          resourceIBMDNSRecord(),
+			"ibm_firewall":                            resourceIBMFirewall(),
+			"ibm_firewall_policy":                     resourceIBMFirewallPolicy(),
+			"ibm_iam_user_policy":                     resourceIBMIAMUserPolicy(),
+			"ibm_lb":                                  resourceIBMLb(),
+			"ibm_lb_service":                          resourceIBMLbService(),
+			"ibm_lb_service_group":                    resourceIBMLbServiceGroup(),
+			"ibm_lb_vpx":                              resourceIBMLbVpx(),
+			"ibm_lb_vpx_ha":                           resourceIBMLbVpxHa(),
+			"ibm_lb_vpx_service":                      resourceIBMLbVpxService(),
+			"ibm_lb_vpx_vip":                          resourceIBMLbVpxVip(),
+			"ibm_lbaas":                               resourceIBMLbaas(),
+			"ibm_lbaas_health_monitor":                resourceIBMLbaasHealthMonitor(),
+			"ibm_lbaas_server_instance_attachment":    resourceIBMLbaasServerInstanceAttachment(),
+			"ibm_network_gateway_vlan_attachment":     resourceIBMNetworkGatewayVlanAttachment(),
+			"ibm_network_interface_sg_attachment":     resourceIBMNetworkInterfaceSGAttachment(),
+			"ibm_network_public_ip":                   resourceIBMNetworkPublicIp(),
+			"ibm_network_secondary_ip":                resourceIBMNetworkSecondaryIp(),
+			"ibm_network_vlan":                        resourceIBMNetworkVlan(),
+			"ibm_object_storage_account":              resourceIBMObjectStorageAccount(),
+			"ibm_service_instance":                    resourceIBMServiceInstance(),
+			"ibm_service_key":                         resourceIBMServiceKey(),
+			"ibm_space":                               resourceIBMSpace(),
+			"ibm_storage_block":                       resourceIBMStorageBlock(),
+			"ibm_storage_file":                        resourceIBMStorageFile(),
+			"ibm_storage_evault":                      resourceIBMStorageEvault(),
 		},
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	for _, r := range provider.ResourcesMap {
+		tagMutatingPhase(r)
+	}
+
+	return &ibmProvider{Provider: provider}
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -121,6 +198,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	softlayerTimeout := d.Get("softlayer_timeout").(int)
 	bluemixTimeout := d.Get("bluemix_timeout").(int)
 	region := d.Get("region").(string)
+	maxConcurrentOrders := d.Get("max_concurrent_orders").(int)
+	dryRunQuote := d.Get("dry_run_quote").(bool)
+	skipDetailedRefresh := d.Get("skip_detailed_refresh").(bool)
+	checkAccountLimits := d.Get("check_account_limits").(bool)
+	debug := d.Get("debug").(bool)
+	correlationID := d.Get("correlation_id").(string)
 
 	config := Config{
 		BluemixAPIKey:        bluemixAPIKey,
@@ -129,6 +212,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		SoftLayerTimeout:     time.Duration(softlayerTimeout) * time.Second,
 		SoftLayerUserName:    softlayerUsername,
 		SoftLayerAPIKey:      softlayerAPIKey,
+		MaxConcurrentOrders:  maxConcurrentOrders,
+		DryRunQuote:          dryRunQuote,
+		SkipDetailedRefresh:  skipDetailedRefresh,
+		CheckAccountLimits:   checkAccountLimits,
+		Debug:                debug,
+		CorrelationID:        correlationID,
 		RetryCount:           3,
 		RetryDelay:           30 * time.Millisecond,
 		SoftLayerEndpointURL: SoftlayerRestEndpoint,
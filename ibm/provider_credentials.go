@@ -0,0 +1,123 @@
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// bluemixResources are the resources which call the Bluemix API, and need bluemix_api_key
+var bluemixResources = map[string]bool{
+	"ibm_app":                    true,
+	"ibm_app_domain_private":     true,
+	"ibm_app_domain_shared":      true,
+	"ibm_app_route":              true,
+	"ibm_cloudant_database":      true,
+	"ibm_cloudant_index":         true,
+	"ibm_cloudant_replication":   true,
+	"ibm_container_bind_service": true,
+	"ibm_container_cluster":      true,
+	"ibm_container_subnet":       true,
+	"ibm_container_worker":       true,
+	"ibm_iam_user_policy":        true,
+	"ibm_service_instance":       true,
+	"ibm_service_key":            true,
+	"ibm_space":                  true,
+}
+
+// bluemixDataSources are the data sources which call the Bluemix API, and need bluemix_api_key
+var bluemixDataSources = map[string]bool{
+	"ibm_account":                  true,
+	"ibm_app":                      true,
+	"ibm_app_domain_private":       true,
+	"ibm_app_domain_shared":        true,
+	"ibm_app_route":                true,
+	"ibm_container_cluster":        true,
+	"ibm_container_cluster_config": true,
+	"ibm_container_cluster_worker": true,
+	"ibm_iam_token":                true,
+	"ibm_iam_user_policy":          true,
+	"ibm_org":                      true,
+	"ibm_service_instance":         true,
+	"ibm_service_key":              true,
+	"ibm_service_plan":             true,
+	"ibm_space":                    true,
+	"ibm_space_roles":              true,
+	"ibm_watson_service_config":    true,
+}
+
+// ibmProvider checks that the provider is configured with the credentials needed by the
+// resources and data sources when they are planned, so that a configuration using Bluemix
+// resources with only the SoftLayer credentials fails before anything is applied
+type ibmProvider struct {
+	*schema.Provider
+}
+
+// Diff implementation of terraform.ResourceProvider interface.
+func (p *ibmProvider) Diff(
+	info *terraform.InstanceInfo,
+	s *terraform.InstanceState,
+	c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+	if err := checkBluemixCredentials(p.Meta(), info.Type, bluemixResources); err != nil {
+		return nil, err
+	}
+	d, err := p.Provider.Diff(info, s, c)
+	if err != nil {
+		return nil, err
+	}
+	d, err = diffPlan(info, s, d)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPlan(p.Meta(), info, s, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Refresh implementation of terraform.ResourceProvider interface.
+func (p *ibmProvider) Refresh(
+	info *terraform.InstanceInfo,
+	s *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if err := checkBluemixCredentials(p.Meta(), info.Type, bluemixResources); err != nil {
+		return nil, err
+	}
+	return p.Provider.Refresh(info, s)
+}
+
+// ReadDataDiff implementation of terraform.ResourceProvider interface.
+func (p *ibmProvider) ReadDataDiff(
+	info *terraform.InstanceInfo,
+	c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+	if err := checkBluemixCredentials(p.Meta(), info.Type, bluemixDataSources); err != nil {
+		return nil, err
+	}
+	return p.Provider.ReadDataDiff(info, c)
+}
+
+// ReadDataApply implementation of terraform.ResourceProvider interface.
+func (p *ibmProvider) ReadDataApply(
+	info *terraform.InstanceInfo,
+	d *terraform.InstanceDiff) (*terraform.InstanceState, error) {
+	if err := checkBluemixCredentials(p.Meta(), info.Type, bluemixDataSources); err != nil {
+		return nil, err
+	}
+	return p.Provider.ReadDataApply(info, d)
+}
+
+// checkBluemixCredentials returns an error naming the resource when it calls the Bluemix API and
+// the provider has no Bluemix session. Nothing is checked before the provider is configured.
+func checkBluemixCredentials(meta interface{}, name string, bluemixTypes map[string]bool) error {
+	if !bluemixTypes[name] {
+		return nil
+	}
+	sess, ok := meta.(ClientSession)
+	if !ok {
+		return nil
+	}
+	if _, err := sess.BluemixSession(); err != nil {
+		return fmt.Errorf("%s requires the Bluemix credentials, configure bluemix_api_key in the provider block or set the BM_API_KEY environment variable. Please see the documentation on how to configure it", name)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package ibm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+
+	bxsession "github.com/IBM-Bluemix/bluemix-go/session"
+)
+
+func TestBluemixTypesAreRegistered(t *testing.T) {
+	provider := Provider().(*ibmProvider)
+	for name := range bluemixResources {
+		if _, ok := provider.ResourcesMap[name]; !ok {
+			t.Errorf("Resource %s is not registered in the provider", name)
+		}
+	}
+	for name := range bluemixDataSources {
+		if _, ok := provider.DataSourcesMap[name]; !ok {
+			t.Errorf("Data source %s is not registered in the provider", name)
+		}
+	}
+}
+
+func TestCheckBluemixCredentials(t *testing.T) {
+	softlayerOnly := clientSession{session: &Session{}}
+	withBluemix := clientSession{session: &Session{BluemixSession: &bxsession.Session{}}}
+
+	err := checkBluemixCredentials(softlayerOnly, "ibm_app", bluemixResources)
+	if err == nil || !strings.Contains(err.Error(), "ibm_app requires") || !strings.Contains(err.Error(), "bluemix_api_key") {
+		t.Errorf("Expected the error to name ibm_app and bluemix_api_key, got %v", err)
+	}
+	if err := checkBluemixCredentials(softlayerOnly, "ibm_compute_ssh_key", bluemixResources); err != nil {
+		t.Errorf("Expected no error for a SoftLayer resource, got %s", err)
+	}
+	if err := checkBluemixCredentials(withBluemix, "ibm_app", bluemixResources); err != nil {
+		t.Errorf("Expected no error with the Bluemix credentials, got %s", err)
+	}
+	if err := checkBluemixCredentials(nil, "ibm_app", bluemixResources); err != nil {
+		t.Errorf("Expected no error before the provider is configured, got %s", err)
+	}
+}
+
+func TestProviderDiffWithoutBluemixCredentials(t *testing.T) {
+	provider := Provider().(*ibmProvider)
+	err := provider.Configure(terraform.NewResourceConfig(nil))
+	if err != nil {
+		t.Fatalf("Unexpected error configuring the provider: %s", err)
+	}
+	if _, err := provider.Meta().(ClientSession).BluemixSession(); err == nil {
+		t.Skip("The Bluemix credentials are set in the environment")
+	}
+
+	config := terraform.NewResourceConfig(nil)
+	_, err = provider.Diff(&terraform.InstanceInfo{Type: "ibm_space"}, nil, config)
+	if err == nil || !strings.Contains(err.Error(), "ibm_space requires") {
+		t.Errorf("Expected the missing Bluemix credentials error for ibm_space, got %v", err)
+	}
+	_, err = provider.ReadDataDiff(&terraform.InstanceInfo{Type: "ibm_org"}, config)
+	if err == nil || !strings.Contains(err.Error(), "ibm_org requires") {
+		t.Errorf("Expected the missing Bluemix credentials error for ibm_org, got %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+package ibm
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/softlayer/softlayer-go/services"
+	slsession "github.com/softlayer/softlayer-go/session"
+)
+
+// planCheck checks a resource when it is planned, so that a plan which cannot be applied fails
+// before anything is created
+type planCheck func(sess ClientSession, info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) error
+
+// resourcePlanChecks are the checks run by the provider on the diff of the resources
+var resourcePlanChecks = map[string][]planCheck{
+	"ibm_compute_vm_instance": {checkHourlyInstanceLimit},
+	"ibm_compute_bare_metal":  {checkHourlyServerLimit},
+	"ibm_firewall_policy":     {checkFirewallPolicyPlan},
+	"ibm_space":               {checkSpacePlan},
+}
+
+// planDiff adds the changes of a resource which the schema can't detect to its diff, such as a
+// change of the content of a file, and returns the diff
+type planDiff func(info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) (*terraform.InstanceDiff, error)
+
+// resourcePlanDiffs are run by the provider on the diff of the resources, before the plan checks.
+// They run when the schema finds no change too.
+var resourcePlanDiffs = map[string]planDiff{
+	"ibm_firewall_policy": diffFirewallRulesFile,
+}
+
+// diffPlan runs the plan diff of the resource, if any
+func diffPlan(info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) (*terraform.InstanceDiff, error) {
+	if f, ok := resourcePlanDiffs[info.Type]; ok {
+		return f(info, s, d)
+	}
+	return d, nil
+}
+
+// checkPlan runs the plan checks of the resource on its diff. Nothing is checked before the
+// provider is configured.
+func checkPlan(meta interface{}, info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) error {
+	sess, ok := meta.(ClientSession)
+	if !ok || d == nil || d.Empty() {
+		return nil
+	}
+	for _, check := range resourcePlanChecks[info.Type] {
+		if err := check(sess, info, s, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkHourlyInstanceLimit(sess ClientSession, info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) error {
+	limits := sess.AccountLimits()
+	if limits == nil || !isHourlyCreation(s, d) {
+		return nil
+	}
+	return limits.hourlyInstances.reserve(info.HumanId())
+}
+
+func checkHourlyServerLimit(sess ClientSession, info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) error {
+	limits := sess.AccountLimits()
+	if limits == nil || !isHourlyCreation(s, d) {
+		return nil
+	}
+	return limits.hourlyServers.reserve(info.HumanId())
+}
+
+// isHourlyCreation tells whether the diff creates a new hourly billed resource. The resources
+// which are replaced are not counted, they are destroyed before being created again.
+func isHourlyCreation(s *terraform.InstanceState, d *terraform.InstanceDiff) bool {
+	if s != nil && s.ID != "" {
+		return false
+	}
+	attr, ok := d.Attributes["hourly_billing"]
+	return ok && attr.New == "true"
+}
+
+// accountLimits are the hourly instance and server limits of the account, checked by the
+// provider when the resources are planned if check_account_limits is set
+type accountLimits struct {
+	hourlyInstances *accountQuota
+	hourlyServers   *accountQuota
+}
+
+func newAccountLimits(sess *slsession.Session) *accountLimits {
+	return &accountLimits{
+		hourlyInstances: newAccountQuota("hourly VM instances", func() (int, int, error) {
+			service := services.GetAccountService(sess)
+			limit, err := service.HourlyInstanceLimit()
+			if err != nil {
+				return 0, 0, fmt.Errorf("Error retrieving the hourly instance limit of the account: %s", err)
+			}
+			guests, err := service.Mask("id").GetHourlyVirtualGuests()
+			if err != nil {
+				return 0, 0, fmt.Errorf("Error retrieving the hourly virtual guests of the account: %s", err)
+			}
+			return limit, len(guests), nil
+		}),
+		hourlyServers: newAccountQuota("hourly bare metal servers", func() (int, int, error) {
+			service := services.GetAccountService(sess)
+			limit, err := service.HourlyServerLimit()
+			if err != nil {
+				return 0, 0, fmt.Errorf("Error retrieving the hourly server limit of the account: %s", err)
+			}
+			servers, err := service.Mask("id").GetHourlyBareMetalInstances()
+			if err != nil {
+				return 0, 0, fmt.Errorf("Error retrieving the hourly bare metal servers of the account: %s", err)
+			}
+			return limit, len(servers), nil
+		}),
+	}
+}
+
+// accountQuota counts the resources planned by the run against the limit of the account. The
+// limit and the current count are read once, when the first resource is planned.
+type accountQuota struct {
+	name string
+	load func() (limit int, count int, err error)
+
+	mu      sync.Mutex
+	loaded  bool
+	limit   int
+	count   int
+	planned map[string]bool
+}
+
+func newAccountQuota(name string, load func() (int, int, error)) *accountQuota {
+	return &accountQuota{
+		name:    name,
+		load:    load,
+		planned: map[string]bool{},
+	}
+}
+
+// reserve counts the resource in the planned resources, and returns an error when the account
+// has not enough capacity left for them. A resource which is planned again is counted once.
+func (q *accountQuota) reserve(id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.loaded {
+		limit, count, err := q.load()
+		if err != nil {
+			return err
+		}
+		q.limit, q.count, q.loaded = limit, count, true
+	}
+
+	if q.planned[id] {
+		return nil
+	}
+	if q.count+len(q.planned)+1 > q.limit {
+		return fmt.Errorf("%s: the account has %d %s out of a limit of %d, and %d more are already planned. Remove resources from the configuration or ask SoftLayer to raise the limit",
+			id, q.count, q.name, q.limit, len(q.planned))
+	}
+	q.planned[id] = true
+	return nil
+}
@@ -0,0 +1,67 @@
+package ibm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestPlanChecksAreRegistered(t *testing.T) {
+	provider := Provider().(*ibmProvider)
+	for name := range resourcePlanChecks {
+		if _, ok := provider.ResourcesMap[name]; !ok {
+			t.Errorf("Resource %s is not registered in the provider", name)
+		}
+	}
+}
+
+func TestAccountQuotaReserve(t *testing.T) {
+	loads := 0
+	quota := newAccountQuota("hourly VM instances", func() (int, int, error) {
+		loads++
+		return 3, 1, nil
+	})
+
+	for _, id := range []string{"ibm_compute_vm_instance.web.0", "ibm_compute_vm_instance.web.1", "ibm_compute_vm_instance.web.0"} {
+		if err := quota.reserve(id); err != nil {
+			t.Fatalf("Unexpected error for %s: %s", id, err)
+		}
+	}
+	err := quota.reserve("ibm_compute_vm_instance.web.2")
+	if err == nil || !strings.Contains(err.Error(), "ibm_compute_vm_instance.web.2") || !strings.Contains(err.Error(), "limit of 3") {
+		t.Fatalf("Expected the limit to be exceeded, got %v", err)
+	}
+	if loads != 1 {
+		t.Errorf("Expected the limit to be loaded once, got %d", loads)
+	}
+}
+
+func TestCheckPlan_accountLimits(t *testing.T) {
+	quota := newAccountQuota("hourly VM instances", func() (int, int, error) {
+		return 1, 1, nil
+	})
+	sess := clientSession{session: &Session{}, accountLimits: &accountLimits{hourlyInstances: quota}}
+	info := &terraform.InstanceInfo{Id: "ibm_compute_vm_instance.web", Type: "ibm_compute_vm_instance"}
+	diff := func(hourly string) *terraform.InstanceDiff {
+		return &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{
+			"hourly_billing": {New: hourly},
+		}}
+	}
+
+	if err := checkPlan(sess, info, nil, diff("false")); err != nil {
+		t.Errorf("Expected a monthly instance not to be checked, got %s", err)
+	}
+	if err := checkPlan(sess, info, &terraform.InstanceState{ID: "123"}, diff("true")); err != nil {
+		t.Errorf("Expected an existing instance not to be checked, got %s", err)
+	}
+	if err := checkPlan(nil, info, nil, diff("true")); err != nil {
+		t.Errorf("Expected nothing to be checked before the provider is configured, got %s", err)
+	}
+	if err := checkPlan(clientSession{session: &Session{}}, info, nil, diff("true")); err != nil {
+		t.Errorf("Expected nothing to be checked when check_account_limits is not set, got %s", err)
+	}
+	if err := checkPlan(sess, info, nil, diff("true")); err == nil {
+		t.Errorf("Expected a new hourly instance to exceed the limit")
+	}
+}
@@ -18,6 +18,12 @@ var datacenter string
 var machineType string
 var publicVlanID string
 var privateVlanID string
+var securityGroupID string
+var secondarySubnetID string
+var lbaasSubnetID string
+var dnsRegistrationName string
+var networkGatewayName string
+var containerSubnetID string
 
 func init() {
 	cfOrganization = os.Getenv("IBM_ORG")
@@ -66,20 +72,51 @@ func init() {
 		privateVlanID = "1764491"
 		fmt.Println("[INFO] Set the environment variable IBM_PRIVATE_VLAN_ID for testing ibm_container_cluster resource else it is set to default value '1764491'")
 	}
+
+	securityGroupID = os.Getenv("IBM_SECURITY_GROUP_ID")
+	if securityGroupID == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_SECURITY_GROUP_ID for testing ibm_network_interface_sg_attachment resource Some tests for that resource will fail if this is not set correctly")
+	}
+
+	secondarySubnetID = os.Getenv("IBM_SECONDARY_SUBNET_ID")
+	if secondarySubnetID == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_SECONDARY_SUBNET_ID for testing ibm_network_secondary_ip resource Some tests for that resource will fail if this is not set correctly")
+	}
+
+	lbaasSubnetID = os.Getenv("IBM_LBAAS_SUBNET_ID")
+	if lbaasSubnetID == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_LBAAS_SUBNET_ID for testing ibm_lbaas resource Some tests for that resource will fail if this is not set correctly")
+	}
+
+	dnsRegistrationName = os.Getenv("IBM_DNS_REGISTRATION_NAME")
+	if dnsRegistrationName == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_DNS_REGISTRATION_NAME for testing ibm_dns_domain_registration data source Some tests for that data source will fail if this is not set correctly")
+	}
+
+	networkGatewayName = os.Getenv("IBM_NETWORK_GATEWAY_NAME")
+	if networkGatewayName == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_NETWORK_GATEWAY_NAME for testing ibm_vpn_gateway data source Some tests for that data source will fail if this is not set correctly")
+	}
+
+	containerSubnetID = os.Getenv("IBM_CONTAINER_SUBNET_ID")
+	if containerSubnetID == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_CONTAINER_SUBNET_ID for testing ibm_container_subnet resource Some tests for that resource will fail if this is not set correctly")
+	}
 }
 
 var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 
 func init() {
-	testAccProvider = Provider().(*schema.Provider)
+	provider := Provider().(*ibmProvider)
+	testAccProvider = provider.Provider
 	testAccProviders = map[string]terraform.ResourceProvider{
-		"ibm": testAccProvider,
+		"ibm": provider,
 	}
 }
 
 func TestProvider(t *testing.T) {
-	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+	if err := Provider().(*ibmProvider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
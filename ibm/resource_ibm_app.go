@@ -61,6 +61,12 @@ func resourceIBMApp() *schema.Resource {
 				Type:        schema.TypeMap,
 				Optional:    true,
 			},
+			"restage_on_environment_change": {
+				Description: "Restage the app instead of restarting it when the environment variables change.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 			"route_guid": {
 				Description: "Define the route guids which should be bound to the application.",
 				Type:        schema.TypeSet,
@@ -287,7 +293,13 @@ func resourceIBMAppUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("environment_json") {
 		appUpdatePayload.EnvironmentJSON = helpers.Map(d.Get("environment_json").(map[string]interface{}))
-		restageRequired = true
+		//The environment variables are applied when the app starts, the droplet only needs to be
+		//rebuilt when the buildpack reads them
+		if d.Get("restage_on_environment_change").(bool) {
+			restageRequired = true
+		} else {
+			restartRequired = true
+		}
 	}
 	log.Println("[INFO] Update cloud foundary application")
 
@@ -331,7 +343,7 @@ func resourceIBMAppUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	//If restage and restart both are required then we only need restage as that starts over everything
 	if restageRequired {
-		log.Println("[INFO] Restage since buildpack has changed")
+		log.Println("[INFO] Restage since buildpack, environment variables or service bindings have changed")
 		err := restageApp(appGUID, d, meta)
 		if err != nil {
 			return err
@@ -59,6 +59,50 @@ func TestAccIBMApp_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMApp_EnvironmentChange(t *testing.T) {
+	var conf mccpv2.AppFields
+	name := fmt.Sprintf("terraform_%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMAppDestroy,
+		Steps: []resource.TestStep{
+
+			resource.TestStep{
+				Config: testAccCheckIBMAppEnvironment(name, "test1", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMAppExists("ibm_app.app", &conf),
+					resource.TestCheckResourceAttr("ibm_app.app", "environment_json.%", "1"),
+					resource.TestCheckResourceAttr("ibm_app.app", "environment_json.test", "test1"),
+				),
+			},
+			resource.TestStep{
+				// Set an environment variable outside of Terraform, like cf set-env
+				PreConfig: testAccSetIBMAppEnvironment(t, &conf, map[string]interface{}{"test": "test1", "manual": "value"}),
+				Config:    testAccCheckIBMAppEnvironment(name, "test1", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_app.app", "environment_json.%", "1"),
+					resource.TestCheckResourceAttr("ibm_app.app", "environment_json.test", "test1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMAppEnvironment(name, "test2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_app.app", "environment_json.test", "test2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMAppEnvironment(name, "test3", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_app.app", "environment_json.test", "test3"),
+					resource.TestCheckResourceAttr("ibm_app.app", "restage_on_environment_change", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMApp_with_routes(t *testing.T) {
 	var conf mccpv2.AppFields
 	name := fmt.Sprintf("terraform_%d", acctest.RandInt())
@@ -245,6 +289,19 @@ func testAccCheckIBMAppExists(n string, obj *mccpv2.AppFields) resource.TestChec
 	}
 }
 
+func testAccSetIBMAppEnvironment(t *testing.T, obj *mccpv2.AppFields, env map[string]interface{}) func() {
+	return func() {
+		cfClient, err := testAccProvider.Meta().(ClientSession).MccpAPI()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = cfClient.Apps().Update(obj.Metadata.GUID, mccpv2.AppRequest{EnvironmentJSON: &env})
+		if err != nil {
+			t.Fatalf("Error setting the environment variables of app %s: %s", obj.Metadata.GUID, err)
+		}
+	}
+}
+
 func testAccCheckIBMAppInvalidPath(name string) string {
 	return fmt.Sprintf(`
 
@@ -623,3 +680,26 @@ resource "ibm_app" "app" {
 }`, cfOrganization, cfSpace, name)
 
 }
+
+func testAccCheckIBMAppEnvironment(name, value string, restage bool) string {
+	return fmt.Sprintf(`
+
+data "ibm_space" "space" {
+  org   = "%s"
+  space = "%s"
+}
+
+resource "ibm_app" "app" {
+  name                          = "%s"
+  space_guid                    = "${data.ibm_space.space.id}"
+  app_path                      = "test-fixtures/app1.zip"
+  wait_time_minutes             = 90
+  buildpack                     = "sdk-for-nodejs"
+  restage_on_environment_change = %t
+
+  environment_json = {
+    "test" = "%s"
+  }
+}`, cfOrganization, cfSpace, name, restage, value)
+
+}
@@ -0,0 +1,244 @@
+package ibm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/IBM-Bluemix/bluemix-go/bmxerror"
+	"github.com/IBM-Bluemix/bluemix-go/rest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIBMCloudantDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCloudantDatabaseCreate,
+		Read:     resourceIBMCloudantDatabaseRead,
+		Delete:   resourceIBMCloudantDatabaseDelete,
+		Exists:   resourceIBMCloudantDatabaseExists,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"service_key_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The guid of the service key whose credentials are used to connect to the Cloudant instance",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the Cloudant database",
+				ValidateFunc: validateCloudantDatabaseName,
+			},
+			"doc_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of documents in the database",
+			},
+			"update_seq": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current update sequence of the database",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	serviceKeyGUID := d.Get("service_key_guid").(string)
+	name := d.Get("name").(string)
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return err
+	}
+
+	err = client.do(rest.PutRequest(client.url(name)), nil)
+	if err != nil {
+		return fmt.Errorf("Error creating cloudant database %s: %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", serviceKeyGUID, name))
+
+	return resourceIBMCloudantDatabaseRead(d, meta)
+}
+
+func resourceIBMCloudantDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	serviceKeyGUID, name, err := parseCloudantDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return err
+	}
+
+	info := cloudantDatabaseInfo{}
+	err = client.do(rest.GetRequest(client.url(name)), &info)
+	if err != nil {
+		return fmt.Errorf("Error retrieving cloudant database %s: %s", name, err)
+	}
+
+	d.Set("service_key_guid", serviceKeyGUID)
+	d.Set("name", info.Name)
+	d.Set("doc_count", info.DocCount)
+	d.Set("update_seq", fmt.Sprintf("%v", info.UpdateSeq))
+
+	return nil
+}
+
+func resourceIBMCloudantDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	serviceKeyGUID, name, err := parseCloudantDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return err
+	}
+
+	err = client.do(rest.DeleteRequest(client.url(name)), nil)
+	if err != nil {
+		return fmt.Errorf("Error deleting cloudant database %s: %s", name, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMCloudantDatabaseExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	serviceKeyGUID, name, err := parseCloudantDatabaseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return false, err
+	}
+
+	err = client.do(rest.HeadRequest(client.url(name)), nil)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
+			if apiErr.StatusCode() == 404 {
+				return false, nil
+			}
+		}
+		return false, fmt.Errorf("Error communicating with the API: %s", err)
+	}
+
+	return true, nil
+}
+
+func parseCloudantDatabaseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Failed to parse id %s: expected <service_key_guid>:<database>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+type cloudantDatabaseInfo struct {
+	Name      string      `json:"db_name"`
+	DocCount  int         `json:"doc_count"`
+	UpdateSeq interface{} `json:"update_seq"`
+}
+
+// cloudantClient talks to a Cloudant instance using the credentials of a service key
+type cloudantClient struct {
+	endpoint string
+	username string
+	password string
+	client   *rest.Client
+}
+
+func cloudantClientFromServiceKey(meta interface{}, serviceKeyGUID string) (*cloudantClient, error) {
+	cfClient, err := meta.(ClientSession).MccpAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	serviceKey, err := cfClient.ServiceKeys().Get(serviceKeyGUID)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving service key: %s", err)
+	}
+
+	creds := flattenServiceKeyCredentials(serviceKey.Entity.Credentials)
+	rawURL, ok := creds["url"]
+	if !ok || rawURL == "" {
+		return nil, fmt.Errorf("Service key %s doesn't contain a cloudant url in its credentials", serviceKeyGUID)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing cloudant url of service key %s: %s", serviceKeyGUID, err)
+	}
+
+	c := &cloudantClient{
+		username: creds["username"],
+		password: creds["password"],
+		client:   rest.NewClient(),
+	}
+	if u.User != nil {
+		c.username = u.User.Username()
+		if p, ok := u.User.Password(); ok {
+			c.password = p
+		}
+		u.User = nil
+	}
+	c.endpoint = strings.TrimSuffix(u.String(), "/")
+
+	return c, nil
+}
+
+func (c *cloudantClient) url(parts ...string) string {
+	escaped := make([]string, len(parts))
+	for i, p := range parts {
+		escaped[i] = url.PathEscape(p)
+	}
+	return fmt.Sprintf("%s/%s", c.endpoint, strings.Join(escaped, "/"))
+}
+
+// authURL returns the url of the database with the credentials embedded, as expected by the replicator
+func (c *cloudantClient) authURL(database string) string {
+	u, _ := url.Parse(c.url(database))
+	u.User = url.UserPassword(c.username, c.password)
+	return u.String()
+}
+
+// database returns the name of the database at the url when the url is a database of the
+// instance of the client, whether its credentials are embedded or not
+func (c *cloudantClient) database(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false
+	}
+	u.User = nil
+	prefix := c.endpoint + "/"
+	full := fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, u.EscapedPath())
+	if !strings.HasPrefix(full, prefix) {
+		return "", false
+	}
+	escaped := strings.TrimPrefix(full, prefix)
+	if escaped == "" || strings.Contains(escaped, "/") {
+		return "", false
+	}
+	database, err := url.PathUnescape(escaped)
+	if err != nil {
+		return "", false
+	}
+	return database, true
+}
+
+func (c *cloudantClient) do(r *rest.Request, respV interface{}) error {
+	auth := base64.StdEncoding.EncodeToString([]byte(c.username + ":" + c.password))
+	r.Set("Authorization", "Basic "+auth)
+	_, err := c.client.Do(r, respV, nil)
+	return err
+}
@@ -0,0 +1,109 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/IBM-Bluemix/bluemix-go/rest"
+)
+
+func TestAccIBMCloudantDatabase_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandInt())
+	serviceKey := fmt.Sprintf("terraform_%d", acctest.RandInt())
+	dbName := fmt.Sprintf("terraform_%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDatabaseDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDatabase_basic(serviceName, serviceKey, dbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCloudantDatabaseExists("ibm_cloudant_database.db"),
+					resource.TestCheckResourceAttr("ibm_cloudant_database.db", "name", dbName),
+					resource.TestCheckResourceAttr("ibm_cloudant_database.db", "doc_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantDatabaseExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		serviceKeyGUID, name, err := parseCloudantDatabaseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, err := cloudantClientFromServiceKey(testAccProvider.Meta(), serviceKeyGUID)
+		if err != nil {
+			return err
+		}
+
+		return client.do(rest.GetRequest(client.url(name)), nil)
+	}
+}
+
+func testAccCheckIBMCloudantDatabaseDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cloudant_database" {
+			continue
+		}
+
+		serviceKeyGUID, name, err := parseCloudantDatabaseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		// The service key is destroyed along with the database, so a failure to
+		// look up its credentials means the database is gone as well.
+		client, err := cloudantClientFromServiceKey(testAccProvider.Meta(), serviceKeyGUID)
+		if err != nil {
+			continue
+		}
+
+		err = client.do(rest.GetRequest(client.url(name)), nil)
+		if err == nil {
+			return fmt.Errorf("Cloudant database %s still exists", name)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMCloudantDatabase_basic(serviceName, serviceKey, dbName string) string {
+	return fmt.Sprintf(`
+		
+		data "ibm_space" "spacedata" {
+			space  = "%s"
+			org    = "%s"
+		}
+		
+		resource "ibm_service_instance" "service" {
+			name              = "%s"
+			space_guid        = "${data.ibm_space.spacedata.id}"
+			service           = "cloudantNoSQLDB"
+			plan              = "Lite"
+		}
+
+		resource "ibm_service_key" "serviceKey" {
+			name = "%s"
+			service_instance_guid = "${ibm_service_instance.service.id}"
+		}
+
+		resource "ibm_cloudant_database" "db" {
+			service_key_guid = "${ibm_service_key.serviceKey.id}"
+			name             = "%s"
+		}
+	`, cfSpace, cfOrganization, serviceName, serviceKey, dbName)
+}
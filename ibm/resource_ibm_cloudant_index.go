@@ -0,0 +1,224 @@
+package ibm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Bluemix/bluemix-go/bmxerror"
+	"github.com/IBM-Bluemix/bluemix-go/rest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIBMCloudantIndex() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCloudantIndexCreate,
+		Read:     resourceIBMCloudantIndexRead,
+		Delete:   resourceIBMCloudantIndexDelete,
+		Exists:   resourceIBMCloudantIndexExists,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"service_key_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The guid of the service key whose credentials are used to connect to the Cloudant instance",
+			},
+			"database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the database in which the index is created",
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The document fields to index",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the index",
+			},
+			"design_doc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The design document in which the index is stored",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "json",
+				ValidateFunc: validateAllowedStringValue([]string{"json", "text"}),
+				Description:  "The type of the index",
+			},
+		},
+	}
+}
+
+type cloudantIndexRequest struct {
+	Index struct {
+		Fields []string `json:"fields"`
+	} `json:"index"`
+	Name string `json:"name,omitempty"`
+	DDoc string `json:"ddoc,omitempty"`
+	Type string `json:"type"`
+}
+
+type cloudantIndexResult struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type cloudantIndex struct {
+	DDoc string `json:"ddoc"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Def  struct {
+		Fields []map[string]interface{} `json:"fields"`
+	} `json:"def"`
+}
+
+func resourceIBMCloudantIndexCreate(d *schema.ResourceData, meta interface{}) error {
+	serviceKeyGUID := d.Get("service_key_guid").(string)
+	database := d.Get("database").(string)
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return err
+	}
+
+	req := cloudantIndexRequest{
+		Name: d.Get("name").(string),
+		DDoc: d.Get("design_doc").(string),
+		Type: d.Get("type").(string),
+	}
+	req.Index.Fields = expandStringList(d.Get("fields").([]interface{}))
+
+	result := cloudantIndexResult{}
+	err = client.do(rest.PostRequest(client.url(database, "_index")).Body(req), &result)
+	if err != nil {
+		return fmt.Errorf("Error creating cloudant index in database %s: %s", database, err)
+	}
+
+	ddoc := strings.TrimPrefix(result.ID, "_design/")
+	d.SetId(fmt.Sprintf("%s:%s:%s:%s", serviceKeyGUID, database, ddoc, result.Name))
+
+	return resourceIBMCloudantIndexRead(d, meta)
+}
+
+func resourceIBMCloudantIndexRead(d *schema.ResourceData, meta interface{}) error {
+	serviceKeyGUID, database, ddoc, name, err := parseCloudantIndexID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return err
+	}
+
+	index, err := getCloudantIndex(client, database, ddoc, name)
+	if err != nil {
+		return err
+	}
+	if index == nil {
+		d.SetId("")
+		return nil
+	}
+
+	fields := make([]string, 0, len(index.Def.Fields))
+	for _, f := range index.Def.Fields {
+		for k := range f {
+			fields = append(fields, k)
+		}
+	}
+
+	d.Set("service_key_guid", serviceKeyGUID)
+	d.Set("database", database)
+	d.Set("design_doc", ddoc)
+	d.Set("name", index.Name)
+	d.Set("type", index.Type)
+	d.Set("fields", fields)
+
+	return nil
+}
+
+func resourceIBMCloudantIndexDelete(d *schema.ResourceData, meta interface{}) error {
+	serviceKeyGUID, database, ddoc, name, err := parseCloudantIndexID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return err
+	}
+
+	indexType := d.Get("type").(string)
+	err = client.do(rest.DeleteRequest(client.url(database, "_index", ddoc, indexType, name)), nil)
+	if err != nil {
+		return fmt.Errorf("Error deleting cloudant index %s: %s", name, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMCloudantIndexExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	serviceKeyGUID, database, ddoc, name, err := parseCloudantIndexID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return false, err
+	}
+
+	index, err := getCloudantIndex(client, database, ddoc, name)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
+			if apiErr.StatusCode() == 404 {
+				return false, nil
+			}
+		}
+		return false, fmt.Errorf("Error communicating with the API: %s", err)
+	}
+
+	return index != nil, nil
+}
+
+func getCloudantIndex(client *cloudantClient, database, ddoc, name string) (*cloudantIndex, error) {
+	result := struct {
+		Indexes []cloudantIndex `json:"indexes"`
+	}{}
+	err := client.do(rest.GetRequest(client.url(database, "_index")), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range result.Indexes {
+		if strings.TrimPrefix(index.DDoc, "_design/") == ddoc && index.Name == name {
+			return &index, nil
+		}
+	}
+	return nil, nil
+}
+
+func parseCloudantIndexID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 4 {
+		return "", "", "", "", fmt.Errorf("Failed to parse id %s: expected <service_key_guid>:<database>:<design_doc>:<name>", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
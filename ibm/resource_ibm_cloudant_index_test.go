@@ -0,0 +1,96 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIBMCloudantIndex_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandInt())
+	serviceKey := fmt.Sprintf("terraform_%d", acctest.RandInt())
+	dbName := fmt.Sprintf("terraform_%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantIndex_basic(serviceName, serviceKey, dbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCloudantIndexExists("ibm_cloudant_index.index"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.index", "name", "by-owner"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.index", "design_doc", "owners"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.index", "type", "json"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.index", "fields.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantIndexExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		serviceKeyGUID, database, ddoc, name, err := parseCloudantIndexID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, err := cloudantClientFromServiceKey(testAccProvider.Meta(), serviceKeyGUID)
+		if err != nil {
+			return err
+		}
+
+		index, err := getCloudantIndex(client, database, ddoc, name)
+		if err != nil {
+			return err
+		}
+		if index == nil {
+			return fmt.Errorf("Cloudant index %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMCloudantIndex_basic(serviceName, serviceKey, dbName string) string {
+	return fmt.Sprintf(`
+		
+		data "ibm_space" "spacedata" {
+			space  = "%s"
+			org    = "%s"
+		}
+		
+		resource "ibm_service_instance" "service" {
+			name              = "%s"
+			space_guid        = "${data.ibm_space.spacedata.id}"
+			service           = "cloudantNoSQLDB"
+			plan              = "Lite"
+		}
+
+		resource "ibm_service_key" "serviceKey" {
+			name = "%s"
+			service_instance_guid = "${ibm_service_instance.service.id}"
+		}
+
+		resource "ibm_cloudant_database" "db" {
+			service_key_guid = "${ibm_service_key.serviceKey.id}"
+			name             = "%s"
+		}
+
+		resource "ibm_cloudant_index" "index" {
+			service_key_guid = "${ibm_service_key.serviceKey.id}"
+			database         = "${ibm_cloudant_database.db.name}"
+			design_doc       = "owners"
+			name             = "by-owner"
+			fields           = ["owner", "created"]
+		}
+	`, cfSpace, cfOrganization, serviceName, serviceKey, dbName)
+}
@@ -0,0 +1,235 @@
+package ibm
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/IBM-Bluemix/bluemix-go/bmxerror"
+	"github.com/IBM-Bluemix/bluemix-go/rest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const cloudantReplicatorDatabase = "_replicator"
+
+func resourceIBMCloudantReplication() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCloudantReplicationCreate,
+		Read:     resourceIBMCloudantReplicationRead,
+		Delete:   resourceIBMCloudantReplicationDelete,
+		Exists:   resourceIBMCloudantReplicationExists,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"service_key_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The guid of the service key whose credentials are used to connect to the Cloudant instance",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the replication document",
+			},
+			"source_database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the database to replicate from",
+			},
+			"target_database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the database to replicate to",
+			},
+			"target_service_key_guid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The guid of the service key of the Cloudant instance hosting the target database, if different from the source instance",
+			},
+			"continuous": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether the replication keeps listening for changes on the source database",
+			},
+			"create_target": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the target database is created if it doesn't exist",
+			},
+			"replication_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the replication as reported by the replicator",
+			},
+		},
+	}
+}
+
+type cloudantReplication struct {
+	ID               string `json:"_id,omitempty"`
+	Rev              string `json:"_rev,omitempty"`
+	Source           string `json:"source"`
+	Target           string `json:"target"`
+	Continuous       bool   `json:"continuous"`
+	CreateTarget     bool   `json:"create_target"`
+	ReplicationState string `json:"_replication_state,omitempty"`
+}
+
+func resourceIBMCloudantReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	serviceKeyGUID := d.Get("service_key_guid").(string)
+	name := d.Get("name").(string)
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return err
+	}
+
+	targetClient := client
+	if guid, ok := d.GetOk("target_service_key_guid"); ok {
+		targetClient, err = cloudantClientFromServiceKey(meta, guid.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	replication := cloudantReplication{
+		Source:       client.authURL(d.Get("source_database").(string)),
+		Target:       targetClient.authURL(d.Get("target_database").(string)),
+		Continuous:   d.Get("continuous").(bool),
+		CreateTarget: d.Get("create_target").(bool),
+	}
+
+	err = client.do(rest.PutRequest(client.url(cloudantReplicatorDatabase, name)).Body(replication), nil)
+	if err != nil {
+		return fmt.Errorf("Error creating cloudant replication %s: %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", serviceKeyGUID, name))
+
+	return resourceIBMCloudantReplicationRead(d, meta)
+}
+
+func resourceIBMCloudantReplicationRead(d *schema.ResourceData, meta interface{}) error {
+	serviceKeyGUID, name, err := parseCloudantDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return err
+	}
+
+	replication := cloudantReplication{}
+	err = client.do(rest.GetRequest(client.url(cloudantReplicatorDatabase, name)), &replication)
+	if err != nil {
+		return fmt.Errorf("Error retrieving cloudant replication %s: %s", name, err)
+	}
+
+	d.Set("service_key_guid", serviceKeyGUID)
+	d.Set("name", name)
+
+	if database, ok := client.database(replication.Source); ok {
+		d.Set("source_database", database)
+	} else {
+		d.Set("source_database", redactedURL(replication.Source))
+	}
+
+	// The target url names the instance but not the service key, so the configured target service key
+	// is kept when the target is still in its instance
+	targetServiceKeyGUID := d.Get("target_service_key_guid").(string)
+	targetDatabase, onTarget := "", false
+	if targetServiceKeyGUID != "" {
+		targetClient, err := cloudantClientFromServiceKey(meta, targetServiceKeyGUID)
+		if err != nil {
+			return err
+		}
+		targetDatabase, onTarget = targetClient.database(replication.Target)
+	}
+	if !onTarget {
+		targetDatabase, onTarget = client.database(replication.Target)
+		targetServiceKeyGUID = ""
+	}
+	if onTarget {
+		d.Set("target_database", targetDatabase)
+		d.Set("target_service_key_guid", targetServiceKeyGUID)
+	} else {
+		d.Set("target_database", redactedURL(replication.Target))
+	}
+
+	d.Set("continuous", replication.Continuous)
+	d.Set("create_target", replication.CreateTarget)
+	d.Set("replication_state", replication.ReplicationState)
+
+	return nil
+}
+
+func resourceIBMCloudantReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	serviceKeyGUID, name, err := parseCloudantDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return err
+	}
+
+	replication := cloudantReplication{}
+	err = client.do(rest.GetRequest(client.url(cloudantReplicatorDatabase, name)), &replication)
+	if err != nil {
+		return fmt.Errorf("Error retrieving cloudant replication %s: %s", name, err)
+	}
+
+	err = client.do(rest.DeleteRequest(client.url(cloudantReplicatorDatabase, name)).Query("rev", replication.Rev), nil)
+	if err != nil {
+		return fmt.Errorf("Error deleting cloudant replication %s: %s", name, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMCloudantReplicationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	serviceKeyGUID, name, err := parseCloudantDatabaseID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	client, err := cloudantClientFromServiceKey(meta, serviceKeyGUID)
+	if err != nil {
+		return false, err
+	}
+
+	err = client.do(rest.HeadRequest(client.url(cloudantReplicatorDatabase, name)), nil)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
+			if apiErr.StatusCode() == 404 {
+				return false, nil
+			}
+		}
+		return false, fmt.Errorf("Error communicating with the API: %s", err)
+	}
+
+	return true, nil
+}
+
+// redactedURL returns the url of a replication database which is not in the instances of the
+// resource, without its credentials
+func redactedURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	u.User = nil
+	return u.String()
+}
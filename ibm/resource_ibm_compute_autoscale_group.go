@@ -508,9 +508,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 		newIds := newValue.(*schema.Set).List()
 
 		// Delete all Vlans
-		oldScaleVlans, err := scaleGroupService.
-			Id(groupId).
-			GetNetworkVlans()
+		oldScaleVlans, err := getScaleGroupNetworkVlans(scaleGroupService.Id(groupId))
 		if err != nil {
 			return fmt.Errorf("Could not retrieve current vlans for scale group (%d): %s", groupId, err)
 		}
@@ -576,7 +574,7 @@ func resourceIBMComputeAutoScaleGroupDelete(d *schema.ResourceData, meta interfa
 
 	log.Printf("[INFO] Deleting scale group: %d", id)
 	_, err = scaleGroupService.Id(id).ForceDeleteObject()
-	if err != nil {
+	if err != nil && !isSoftLayerNotFound(err) {
 		return fmt.Errorf("Error deleting scale group: %s", err)
 	}
 
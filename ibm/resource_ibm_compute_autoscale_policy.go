@@ -325,7 +325,7 @@ func resourceIBMComputeAutoScalePolicyDelete(d *schema.ResourceData, meta interf
 
 	log.Printf("[INFO] Deleting scale policy: %d", id)
 	_, err = service.Id(id).DeleteObject()
-	if err != nil {
+	if err != nil && !isSoftLayerNotFound(err) {
 		return fmt.Errorf("Error deleting scale policy: %s", err)
 	}
 
@@ -25,7 +25,9 @@ func resourceIBMComputeBareMetal() *schema.Resource {
 		Update:   resourceIBMComputeBareMetalUpdate,
 		Delete:   resourceIBMComputeBareMetalDelete,
 		Exists:   resourceIBMComputeBareMetalExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMComputeBareMetalImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -147,7 +149,6 @@ func resourceIBMComputeBareMetal() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Default:  100,
-				ForceNew: true,
 			},
 
 			"hourly_billing": {
@@ -205,12 +206,20 @@ func resourceIBMComputeBareMetal() *schema.Resource {
 				DiffSuppressFunc: applyOnce,
 			},
 
+			// Monthly only
+			"gpu_key_names": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				ForceNew:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: applyOnce,
+			},
+
 			// Monthly only
 			"redundant_network": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
 			},
 
 			// Monthly only
@@ -218,7 +227,6 @@ func resourceIBMComputeBareMetal() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
 			},
 
 			// Monthly only
@@ -323,6 +331,17 @@ func resourceIBMComputeBareMetal() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"os_username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -433,8 +452,12 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 			order.Hardware,
 			hardware,
 		)
-	} else if _, ok := d.GetOk("fixed_config_preset"); ok {
+	} else if preset, ok := d.GetOk("fixed_config_preset"); ok {
 		// Build an hourly bare metal server template using fixed_config_preset.
+		err = validateFixedConfigPreset(sess, preset.(string), d.Get("datacenter").(string))
+		if err != nil {
+			return err
+		}
 		hardware, err = getBareMetalOrderFromResourceData(d, meta)
 		if err != nil {
 			return err
@@ -460,22 +483,23 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Println("[INFO] Ordering bare metal server")
-	_, err = services.GetProductOrderService(sess).PlaceOrder(&order, sl.Bool(false))
+	receipt, err := placeOrder(meta, order.PackageId, &order)
 	if err != nil {
 		return fmt.Errorf("Error ordering bare metal server: %s\n%+v\n", err, order)
 	}
 
-	log.Printf("[INFO] Bare Metal Server ID: %s", d.Id())
+	setPendingOrderID(d, *receipt.OrderId)
 
 	// wait for machine availability
 	bm, err := waitForBareMetalProvision(&hardware, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for bare metal server (%s) to become ready: %s", d.Id(), err)
+		logPendingOrderWait("bare metal server", *receipt.OrderId, err)
+		return nil
 	}
 
 	id := *bm.(datatypes.Hardware).Id
 	d.SetId(fmt.Sprintf("%d", id))
+	log.Printf("[INFO] Bare Metal Server ID: %s", d.Id())
 
 	// Set tags
 	err = setHardwareTags(id, d, meta)
@@ -512,6 +536,13 @@ func resourceIBMComputeBareMetalCreate(d *schema.ResourceData, meta interface{})
 func resourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{}) error {
 	service := services.GetHardwareService(meta.(ClientSession).SoftLayerSession())
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingBareMetal(d, meta)
+		if err != nil || !provisioned {
+			return err
+		}
+	}
+
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
@@ -599,15 +630,7 @@ func resourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("os_reference_code", *result.OperatingSystem.SoftwareLicense.SoftwareDescription.ReferenceCode)
 	}
 
-	tagReferences := result.TagReferences
-	tagReferencesLen := len(tagReferences)
-	if tagReferencesLen > 0 {
-		tags := make([]string, 0, tagReferencesLen)
-		for _, tagRef := range tagReferences {
-			tags = append(tags, *tagRef.Tag.Name)
-		}
-		d.Set("tags", tags)
-	}
+	d.Set("tags", flattenTagReferences(result.TagReferences))
 
 	storages := result.AllowedNetworkStorage
 	if len(storages) > 0 {
@@ -621,12 +644,32 @@ func resourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{}) e
 	} else {
 		connInfo["host"] = *result.PrimaryBackendIpAddress
 	}
+	// The credentials of the operating system are only looked up when the server is created or
+	// imported, so that the password is not read again on every refresh
+	if d.IsNewResource() || d.Get("os_username").(string) == "" {
+		operatingSystem, err := service.Id(id).Mask(osPasswordsMask).GetOperatingSystem()
+		if err != nil {
+			log.Printf("[WARN] Error retrieving the operating system credentials of bare metal server %d: %s", id, err)
+		} else {
+			username, password := flattenOSCredentials(operatingSystem.Passwords)
+			d.Set("os_username", username)
+			d.Set("os_password", password)
+		}
+	}
+	if username := d.Get("os_username").(string); username != "" {
+		connInfo["user"] = username
+		connInfo["password"] = d.Get("os_password").(string)
+	}
 	d.SetConnInfo(connInfo)
 
 	return nil
 }
 
 func resourceIBMComputeBareMetalUpdate(d *schema.ResourceData, meta interface{}) error {
+	if isPendingOrderID(d.Id()) {
+		return pendingOrderNotProvisionedError("bare metal server", d.Id())
+	}
+
 	id, _ := strconv.Atoi(d.Id())
 	service := services.GetHardwareService(meta.(ClientSession).SoftLayerSession())
 
@@ -648,18 +691,74 @@ func resourceIBMComputeBareMetalUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	if d.HasChange("network_speed") || d.HasChange("redundant_network") || d.HasChange("unbonded_network") {
+		err := upgradeBareMetalNetwork(id, d, meta)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// resourceIBMComputeBareMetalImportState imports a bare metal server by its ID, or by its fully
+// qualified domain name when the ID is not numeric
+func resourceIBMComputeBareMetalImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	hostname, domain, err := parseComputeFQDN(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	hardware, err := getAccountHardware(services.GetAccountService(meta.(ClientSession).SoftLayerSession()).
+		Mask("id").
+		Filter(filter.Build(
+			filter.Path("hardware.hostname").Eq(hostname),
+			filter.Path("hardware.domain").Eq(domain),
+		)))
+	if err != nil {
+		return nil, fmt.Errorf("Error looking up bare metal server %s: %s", d.Id(), err)
+	}
+
+	ids := make([]int, 0, len(hardware))
+	for _, hw := range hardware {
+		ids = append(ids, *hw.Id)
+	}
+	id, err := selectComputeImportID("bare metal server", d.Id(), ids)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(strconv.Itoa(id))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMComputeBareMetalDelete(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetHardwareService(sess)
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingBareMetal(d, meta)
+		if err != nil {
+			return err
+		}
+		if !provisioned {
+			return pendingOrderNotProvisionedError("bare metal server", d.Id())
+		}
+	}
+
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
 	}
 
+	_, err = service.Id(id).Mask("id").GetObject()
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The bare metal server %d was already deleted", id)
+		return nil
+	}
+
 	_, err = waitForNoBareMetalActiveTransactions(id, meta)
 	if err != nil {
 		return fmt.Errorf("Error deleting bare metal server while waiting for zero active transactions: %s", err)
@@ -683,6 +782,10 @@ func resourceIBMComputeBareMetalDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIBMComputeBareMetalExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	if isPendingOrderID(d.Id()) {
+		return true, nil
+	}
+
 	service := services.GetHardwareService(meta.(ClientSession).SoftLayerSession())
 
 	id, err := strconv.Atoi(d.Id())
@@ -714,12 +817,12 @@ func waitForBareMetalProvision(d *datatypes.Hardware, meta interface{}) (interfa
 		Target:  []string{"provisioned"},
 		Refresh: func() (interface{}, string, error) {
 			service := services.GetAccountService(meta.(ClientSession).SoftLayerSession())
-			bms, err := service.Filter(
+			bms, err := getAccountHardware(service.Filter(
 				filter.Build(
 					filter.Path("hardware.hostname").Eq(hostname),
 					filter.Path("hardware.domain").Eq(domain),
 				),
-			).Mask("id,provisionDate").GetHardware()
+			).Mask("id,provisionDate"))
 			if err != nil {
 				return false, "retry", nil
 			}
@@ -739,6 +842,117 @@ func waitForBareMetalProvision(d *datatypes.Hardware, meta interface{}) (interfa
 	return stateConf.WaitForState()
 }
 
+// adoptPendingBareMetal replaces the pending order id of the bare metal server by the id of the
+// server once it is provisioned, and tells whether it is
+func adoptPendingBareMetal(d *schema.ResourceData, meta interface{}) (bool, error) {
+	orderId, err := pendingOrderID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	service := services.GetAccountService(meta.(ClientSession).SoftLayerSession())
+	bms, err := getAccountHardware(service.Filter(
+		filter.Build(
+			filter.Path("hardware.billingItem.orderItem.order.id").Eq(strconv.Itoa(orderId)),
+		),
+	).Mask("id,provisionDate"))
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving bare metal server of order %d: %s", orderId, err)
+	}
+	if len(bms) != 1 || bms[0].ProvisionDate == nil {
+		log.Printf("[INFO] The bare metal server of order %d is not provisioned yet", orderId)
+		return false, nil
+	}
+	d.SetId(strconv.Itoa(*bms[0].Id))
+	log.Printf("[INFO] Adopted bare metal server %s of order %d", d.Id(), orderId)
+	return true, nil
+}
+
+// upgradeBareMetalNetwork orders the port speed matching network_speed, redundant_network and
+// unbonded_network for the bare metal server, and waits for the upgrade to complete
+func upgradeBareMetalNetwork(id int, d *schema.ResourceData, meta interface{}) error {
+	service := services.GetHardwareServerService(meta.(ClientSession).SoftLayerSession())
+
+	hardware, err := service.Id(id).Mask("id,billingItem[package[id]]").GetObject()
+	if err != nil {
+		return fmt.Errorf("Error retrieving bare metal server %d: %s", id, err)
+	}
+	packageID, ok := sl.Grab(hardware, "BillingItem.Package.Id").(int)
+	if !ok {
+		return fmt.Errorf("Couldn't find the package of bare metal server %d", id)
+	}
+
+	prices, err := service.Id(id).Mask("id,locationGroupId,categories[categoryCode],item[keyName]").GetUpgradeItemPrices()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the upgrade prices of bare metal server %d: %s", id, err)
+	}
+	price, err := findNetworkUpgradeItemPrice(prices, d)
+	if err != nil {
+		return err
+	}
+
+	order := datatypes.Container_Product_Order_Hardware_Server_Upgrade{
+		Container_Product_Order_Hardware_Server: datatypes.Container_Product_Order_Hardware_Server{
+			Container_Product_Order: datatypes.Container_Product_Order{
+				PackageId: sl.Int(packageID),
+				Hardware: []datatypes.Hardware{
+					{Id: sl.Int(id)},
+				},
+				Prices: []datatypes.Product_Item_Price{price},
+				Properties: []datatypes.Container_Product_Order_Property{
+					{
+						Name:  sl.String("MAINTENANCE_WINDOW"),
+						Value: sl.String(time.Now().UTC().Format(time.RFC3339)),
+					},
+				},
+			},
+		},
+	}
+
+	log.Printf("[INFO] Upgrading the network of bare metal server %d", id)
+	_, err = placeOrder(meta, order.PackageId, &order)
+	if err != nil {
+		return fmt.Errorf("Couldn't upgrade the network of bare metal server %d: %s", id, err)
+	}
+
+	_, err = waitForBareMetalUpgradeTransactionsToAppear(id, meta)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the network upgrade of bare metal server %d to start: %s", id, err)
+	}
+	_, err = waitForNoBareMetalActiveTransactions(id, meta)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the network upgrade of bare metal server %d to complete: %s", id, err)
+	}
+
+	return nil
+}
+
+func waitForBareMetalUpgradeTransactionsToAppear(id int, meta interface{}) (interface{}, error) {
+	log.Printf("Waiting for server (%d) to have upgrade transactions", id)
+	service := services.GetHardwareServerService(meta.(ClientSession).SoftLayerSession())
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", pendingUpgrade},
+		Target:  []string{inProgressUpgrade},
+		Refresh: func() (interface{}, string, error) {
+			transactions, err := service.Id(id).Mask("id,transactionStatus[name]").GetActiveTransactions()
+			if err != nil {
+				return false, "retry", nil
+			}
+			for _, transaction := range transactions {
+				if strings.Contains(sl.Grab(transaction, "TransactionStatus.Name", "").(string), upgradeTransaction) {
+					return transactions, inProgressUpgrade, nil
+				}
+			}
+			return transactions, pendingUpgrade, nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
 func waitForNoBareMetalActiveTransactions(id int, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%d) to have zero active transactions", id)
 	service := services.GetHardwareServerService(meta.(ClientSession).SoftLayerSession())
@@ -856,6 +1070,11 @@ func getMonthlyBareMetalOrder(d *schema.ResourceData, meta interface{}) (datatyp
 		return datatypes.Container_Product_Order{}, err
 	}
 
+	err = validatePackageDatacenter(sess, pkg, datacenter.(string))
+	if err != nil {
+		return datatypes.Container_Product_Order{}, err
+	}
+
 	// 2. Get all prices for the package
 	items, err := product.GetPackageProducts(sess, *pkg.Id, "id,categories,capacity,description,units,keyName,prices[id,categories[id,name,categoryCode]]")
 	if err != nil {
@@ -969,6 +1188,16 @@ func getMonthlyBareMetalOrder(d *schema.ResourceData, meta interface{}) (datatyp
 		}
 	}
 
+	// Add prices of GPUs.
+	gpus := d.Get("gpu_key_names").([]interface{})
+	for i, gpu := range gpus {
+		gpuPrice, err := getItemPriceId(items, "gpu"+strconv.Itoa(i), gpu.(string))
+		if err != nil {
+			return datatypes.Container_Product_Order{}, err
+		}
+		order.Prices = append(order.Prices, gpuPrice)
+	}
+
 	// Add redundant power supply
 	if d.Get("redundant_power_supply").(bool) {
 		powerSupply, err := getItemPriceId(items, "power_supply", "REDUNDANT_POWER_SUPPLY")
@@ -1066,35 +1295,10 @@ func findNetworkItemPriceId(items []datatypes.Product_Item, d *schema.ResourceDa
 	unbondedNetwork := d.Get("unbonded_network").(bool)
 	privateNetworkOnly := d.Get("private_network_only").(bool)
 
-	networkSpeedStr := "_MBPS_"
-	redundantNetworkStr := ""
-	unbondedNetworkStr := ""
-
-	if networkSpeed < 1000 {
-		networkSpeedStr = strconv.Itoa(networkSpeed) + networkSpeedStr
-	} else {
-		networkSpeedStr = strconv.Itoa(networkSpeed/1000) + "_GBPS"
-	}
-	if redundantNetwork {
-		redundantNetworkStr = "_REDUNDANT"
-	}
-
-	if unbondedNetwork {
-		unbondedNetworkStr = "_UNBONDED"
-	}
-
 	for _, item := range items {
 		for _, itemCategory := range item.Categories {
 			if *itemCategory.CategoryCode == "port_speed" &&
-				strings.HasPrefix(*item.KeyName, networkSpeedStr) &&
-				strings.Contains(*item.KeyName, redundantNetworkStr) &&
-				strings.Contains(*item.KeyName, unbondedNetworkStr) {
-				if (privateNetworkOnly && strings.Contains(*item.KeyName, "_PUBLIC_PRIVATE")) ||
-					(!privateNetworkOnly && !strings.Contains(*item.KeyName, "_PUBLIC_PRIVATE")) ||
-					(!unbondedNetwork && strings.Contains(*item.KeyName, "_UNBONDED")) ||
-					!redundantNetwork && strings.Contains(*item.KeyName, "_REDUNDANT") {
-					break
-				}
+				matchNetworkItemKeyName(*item.KeyName, networkSpeed, redundantNetwork, unbondedNetwork, privateNetworkOnly) {
 				for _, price := range item.Prices {
 					if price.LocationGroupId == nil {
 						return datatypes.Product_Item_Price{Id: price.Id}, nil
@@ -1104,8 +1308,51 @@ func findNetworkItemPriceId(items []datatypes.Product_Item, d *schema.ResourceDa
 		}
 	}
 	return datatypes.Product_Item_Price{},
-		fmt.Errorf("Could not find the network with %s, %s, %s, and private_network_only = %t",
-			networkSpeedStr, redundantNetworkStr, unbondedNetworkStr, privateNetworkOnly)
+		fmt.Errorf("Could not find the network with network_speed = %d, redundant_network = %t, unbonded_network = %t and private_network_only = %t",
+			networkSpeed, redundantNetwork, unbondedNetwork, privateNetworkOnly)
+}
+
+// Find the upgrade price of the port speed using network options
+func findNetworkUpgradeItemPrice(prices []datatypes.Product_Item_Price, d *schema.ResourceData) (datatypes.Product_Item_Price, error) {
+	networkSpeed := d.Get("network_speed").(int)
+	redundantNetwork := d.Get("redundant_network").(bool)
+	unbondedNetwork := d.Get("unbonded_network").(bool)
+	privateNetworkOnly := d.Get("private_network_only").(bool)
+
+	for _, price := range prices {
+		if price.LocationGroupId != nil || price.Item == nil || price.Item.KeyName == nil {
+			continue
+		}
+		for _, category := range price.Categories {
+			if sl.Get(category.CategoryCode, "").(string) == "port_speed" &&
+				matchNetworkItemKeyName(*price.Item.KeyName, networkSpeed, redundantNetwork, unbondedNetwork, privateNetworkOnly) {
+				return datatypes.Product_Item_Price{Id: price.Id}, nil
+			}
+		}
+	}
+	return datatypes.Product_Item_Price{},
+		fmt.Errorf("Could not find the network upgrade with network_speed = %d, redundant_network = %t, unbonded_network = %t and private_network_only = %t",
+			networkSpeed, redundantNetwork, unbondedNetwork, privateNetworkOnly)
+}
+
+// matchNetworkItemKeyName tells whether the key name of a port_speed item, such as
+// 1_GBPS_PUBLIC_PRIVATE_NETWORK_UPLINKS_REDUNDANT, matches the network options
+func matchNetworkItemKeyName(keyName string, networkSpeed int, redundantNetwork, unbondedNetwork, privateNetworkOnly bool) bool {
+	networkSpeedStr := "_MBPS_"
+	if networkSpeed < 1000 {
+		networkSpeedStr = strconv.Itoa(networkSpeed) + networkSpeedStr
+	} else {
+		networkSpeedStr = strconv.Itoa(networkSpeed/1000) + "_GBPS"
+	}
+
+	if !strings.HasPrefix(keyName, networkSpeedStr) {
+		return false
+	}
+	if privateNetworkOnly == strings.Contains(keyName, "_PUBLIC_PRIVATE") {
+		return false
+	}
+	return redundantNetwork == strings.Contains(keyName, "_REDUNDANT") &&
+		unbondedNetwork == strings.Contains(keyName, "_UNBONDED")
 }
 
 // Find memory price item using memory size.
@@ -1165,6 +1412,51 @@ func getPackageByModel(sess *session.Session, model string) (datatypes.Product_P
 	return datatypes.Product_Package{}, fmt.Errorf("No custom bare metal package key name for %s. Available package key name(s) is(are) %s", model, availableModels)
 }
 
+// validateFixedConfigPreset checks that the fixed configuration preset, such as a GPU or
+// SAP-certified preset, exists and that its package can be ordered in the datacenter
+func validateFixedConfigPreset(sess *session.Session, presetKeyName string, datacenter string) error {
+	presets, err := services.GetProductPackagePresetService(sess).
+		Mask("id,keyName,packageId,package[id,keyName]").
+		Filter(filter.Path("keyName").Eq(presetKeyName).Build()).
+		GetAllObjects()
+	if err != nil {
+		return fmt.Errorf("Error retrieving fixed configuration preset %s: %s", presetKeyName, err)
+	}
+	if len(presets) == 0 || presets[0].Package == nil {
+		return fmt.Errorf("No fixed configuration preset %s was found. The available presets are listed in fixedConfigurationPresets of SoftLayer_Hardware::getCreateObjectOptions", presetKeyName)
+	}
+	if datacenter == "" {
+		return nil
+	}
+	return validatePackageDatacenter(sess, *presets[0].Package, datacenter)
+}
+
+// validatePackageDatacenter checks that the package can be ordered in the datacenter, as
+// specialized packages such as GPU and SAP-certified servers are only offered in some datacenters
+func validatePackageDatacenter(sess *session.Session, pkg datatypes.Product_Package, datacenter string) error {
+	locations, err := services.GetProductPackageService(sess).
+		Id(*pkg.Id).
+		Mask("isAvailable,location[name]").
+		GetAvailableLocations()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the datacenters of package %s: %s", sl.Get(pkg.KeyName, ""), err)
+	}
+
+	availableDatacenters := make([]string, 0, len(locations))
+	for _, location := range locations {
+		if sl.Get(location.IsAvailable, 1).(int) != 1 {
+			continue
+		}
+		name := sl.Grab(location, "Location.Name", "").(string)
+		if name == datacenter {
+			return nil
+		}
+		availableDatacenters = append(availableDatacenters, name)
+	}
+
+	return fmt.Errorf("The package %s is not offered in datacenter %s. Available datacenter(s) is(are) %s", sl.Get(pkg.KeyName, ""), datacenter, strings.Join(availableDatacenters, ", "))
+}
+
 func getStorageGroupsFromResourceData(d *schema.ResourceData) []datatypes.Container_Product_Order_Storage_Group {
 	storageGroupLists := d.Get("storage_groups").([]interface{})
 	storageGroups := make([]datatypes.Container_Product_Order_Storage_Group, 0)
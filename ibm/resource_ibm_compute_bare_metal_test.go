@@ -3,6 +3,7 @@ package ibm
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -172,6 +173,34 @@ func TestAccSoftLayerBareMetalCustom_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMComputeBareMetal_InvalidPreset(t *testing.T) {
+	hostname := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckIBMComputeBareMetalConfig_preset(hostname, "NO_SUCH_PRESET", "dal01"),
+				ExpectError: regexp.MustCompile("No fixed configuration preset NO_SUCH_PRESET was found"),
+			},
+		},
+	})
+}
+
+func TestAccIBMComputeBareMetal_PresetNotInDatacenter(t *testing.T) {
+	hostname := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckIBMComputeBareMetalConfig_preset(hostname, "S1270_32GB_1X1TBSATA_NORAID", "nodc01"),
+				ExpectError: regexp.MustCompile("is not offered in datacenter nodc01"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMComputeBareMetalDestroy(s *terraform.State) error {
 	service := services.GetHardwareService(testAccProvider.Meta().(ClientSession).SoftLayerSession())
 
@@ -251,6 +280,20 @@ resource "ibm_compute_bare_metal" "terraform-acceptance-test-1" {
 `, hostname)
 }
 
+func testAccCheckIBMComputeBareMetalConfig_preset(hostname, preset, datacenter string) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_bare_metal" "terraform-acceptance-test-1" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "UBUNTU_16_64"
+    datacenter = "%s"
+    network_speed = 100
+    hourly_billing = true
+    fixed_config_preset = "%s"
+}
+`, hostname, datacenter, preset)
+}
+
 func testAccCheckIBMComputeBareMetalConfig_update(hostname string) string {
 	return fmt.Sprintf(`
 resource "ibm_compute_bare_metal" "terraform-acceptance-test-1" {
@@ -348,3 +391,31 @@ resource "ibm_compute_bare_metal" "bm-custom" {
 }
 `, hostname, domain)
 }
+
+func TestMatchNetworkItemKeyName(t *testing.T) {
+	testCases := []struct {
+		keyName            string
+		networkSpeed       int
+		redundantNetwork   bool
+		unbondedNetwork    bool
+		privateNetworkOnly bool
+		match              bool
+	}{
+		{"100_MBPS_PUBLIC_PRIVATE_NETWORK_UPLINKS", 100, false, false, false, true},
+		{"100_MBPS_PRIVATE_NETWORK_UPLINK", 100, false, false, true, true},
+		{"100_MBPS_PRIVATE_NETWORK_UPLINK", 100, false, false, false, false},
+		{"1_GBPS_PUBLIC_PRIVATE_NETWORK_UPLINKS", 1000, false, false, false, true},
+		{"1_GBPS_PUBLIC_PRIVATE_NETWORK_UPLINKS_REDUNDANT", 1000, false, false, false, false},
+		{"1_GBPS_PUBLIC_PRIVATE_NETWORK_UPLINKS_REDUNDANT", 1000, true, false, false, true},
+		{"1_GBPS_PUBLIC_PRIVATE_NETWORK_UPLINKS_UNBONDED", 1000, false, true, false, true},
+		{"10_GBPS_PUBLIC_PRIVATE_NETWORK_UPLINKS", 1000, false, false, false, false},
+	}
+
+	for _, tc := range testCases {
+		match := matchNetworkItemKeyName(tc.keyName, tc.networkSpeed, tc.redundantNetwork, tc.unbondedNetwork, tc.privateNetworkOnly)
+		if match != tc.match {
+			t.Errorf("Expected %s to match %d Mbps, redundant %t, unbonded %t, private %t: %t",
+				tc.keyName, tc.networkSpeed, tc.redundantNetwork, tc.unbondedNetwork, tc.privateNetworkOnly, tc.match)
+		}
+	}
+}
@@ -265,7 +265,7 @@ func resourceIBMComputeMonitorDelete(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] Deleting Basic Monitor : %d", id)
 	_, err = service.Id(id).DeleteObject()
-	if err != nil {
+	if err != nil && !isSoftLayerNotFound(err) {
 		return fmt.Errorf("Error deleting Basic Monitor : %s", err)
 	}
 
@@ -0,0 +1,157 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func resourceIBMComputeMonitorNotification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMComputeMonitorNotificationCreate,
+		Read:   resourceIBMComputeMonitorNotificationRead,
+		Delete: resourceIBMComputeMonitorNotificationDelete,
+		Exists: resourceIBMComputeMonitorNotificationExists,
+
+		Schema: map[string]*schema.Schema{
+			"guest_id": {
+				Description:   "The ID of the monitored virtual guest",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"hardware_id"},
+			},
+
+			"hardware_id": {
+				Description:   "The ID of the monitored bare metal server",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"guest_id"},
+			},
+
+			"user_id": {
+				Description: "The ID of the user notified of the monitoring alerts",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceIBMComputeMonitorNotificationCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	userID := d.Get("user_id").(int)
+
+	var id int
+	if guestID, ok := d.GetOk("guest_id"); ok {
+		notification, err := services.GetUserCustomerNotificationVirtualGuestService(sess).
+			CreateObject(&datatypes.User_Customer_Notification_Virtual_Guest{
+				GuestId: sl.Int(guestID.(int)),
+				UserId:  sl.Int(userID),
+			})
+		if err != nil {
+			return fmt.Errorf("Error creating notification of user %d for virtual guest %d: %s", userID, guestID.(int), err)
+		}
+		id = *notification.Id
+	} else if hardwareID, ok := d.GetOk("hardware_id"); ok {
+		notification, err := services.GetUserCustomerNotificationHardwareService(sess).
+			CreateObject(&datatypes.User_Customer_Notification_Hardware{
+				HardwareId: sl.Int(hardwareID.(int)),
+				UserId:     sl.Int(userID),
+			})
+		if err != nil {
+			return fmt.Errorf("Error creating notification of user %d for bare metal server %d: %s", userID, hardwareID.(int), err)
+		}
+		id = *notification.Id
+	} else {
+		return fmt.Errorf("One of guest_id or hardware_id must be set")
+	}
+
+	d.SetId(strconv.Itoa(id))
+	log.Printf("[INFO] Monitor notification ID: %d", id)
+
+	return resourceIBMComputeMonitorNotificationRead(d, meta)
+}
+
+func resourceIBMComputeMonitorNotificationRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+	}
+
+	if _, ok := d.GetOk("guest_id"); ok {
+		notification, err := services.GetUserCustomerNotificationVirtualGuestService(sess).
+			Id(id).Mask("id,guestId,userId").GetObject()
+		if err != nil {
+			return fmt.Errorf("Error retrieving monitor notification %d: %s", id, err)
+		}
+		d.Set("guest_id", sl.Get(notification.GuestId, 0))
+		d.Set("user_id", sl.Get(notification.UserId, 0))
+		return nil
+	}
+
+	notification, err := services.GetUserCustomerNotificationHardwareService(sess).
+		Id(id).Mask("id,hardwareId,userId").GetObject()
+	if err != nil {
+		return fmt.Errorf("Error retrieving monitor notification %d: %s", id, err)
+	}
+	d.Set("hardware_id", sl.Get(notification.HardwareId, 0))
+	d.Set("user_id", sl.Get(notification.UserId, 0))
+
+	return nil
+}
+
+func resourceIBMComputeMonitorNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+	}
+
+	log.Printf("[INFO] Deleting monitor notification: %d", id)
+	if _, ok := d.GetOk("guest_id"); ok {
+		_, err = services.GetUserCustomerNotificationVirtualGuestService(sess).
+			DeleteObjects([]datatypes.User_Customer_Notification_Virtual_Guest{{Id: sl.Int(id)}})
+	} else {
+		_, err = services.GetUserCustomerNotificationHardwareService(sess).
+			DeleteObjects([]datatypes.User_Customer_Notification_Hardware{{Id: sl.Int(id)}})
+	}
+	if err != nil && !isSoftLayerNotFound(err) {
+		return fmt.Errorf("Error deleting monitor notification %d: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMComputeMonitorNotificationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+	}
+
+	if _, ok := d.GetOk("guest_id"); ok {
+		_, err = services.GetUserCustomerNotificationVirtualGuestService(sess).Id(id).Mask("id").GetObject()
+	} else {
+		_, err = services.GetUserCustomerNotificationHardwareService(sess).Id(id).Mask("id").GetObject()
+	}
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error retrieving monitor notification %d: %s", id, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,73 @@
+package ibm
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/softlayer/softlayer-go/services"
+)
+
+func TestAccIBMComputeMonitorNotification_Basic(t *testing.T) {
+	hostname := acctest.RandString(16)
+	userID := 6575505
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMComputeMonitorNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMComputeMonitorNotificationConfig(hostname, userID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_compute_monitor_notification.notification", "guest_id",
+						"ibm_compute_vm_instance.monitored", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_compute_monitor_notification.notification", "user_id", strconv.Itoa(userID)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMComputeMonitorNotificationDestroy(s *terraform.State) error {
+	service := services.GetUserCustomerNotificationVirtualGuestService(testAccProvider.Meta().(ClientSession).SoftLayerSession())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_compute_monitor_notification" {
+			continue
+		}
+
+		id, _ := strconv.Atoi(rs.Primary.ID)
+		_, err := service.Id(id).GetObject()
+		if err == nil {
+			return fmt.Errorf("Monitor notification %d still exists", id)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMComputeMonitorNotificationConfig(hostname string, userID int) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_vm_instance" "monitored" {
+    hostname = "%s"
+    domain = "terraformmonitoruat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "dal06"
+    network_speed = 10
+    hourly_billing = true
+    cores = 1
+    memory = 1024
+    local_disk = false
+}
+
+resource "ibm_compute_monitor_notification" "notification" {
+    guest_id = "${ibm_compute_vm_instance.monitored.id}"
+    user_id = %d
+}`, hostname, userID)
+}
@@ -118,7 +118,7 @@ func resourceIBMComputeProvisioningHookDelete(d *schema.ResourceData, meta inter
 	hookId, err := strconv.Atoi(d.Id())
 	log.Printf("[INFO] Deleting Provisioning Hook: %d", hookId)
 	_, err = service.Id(hookId).DeleteObject()
-	if err != nil {
+	if err != nil && !isSoftLayerNotFound(err) {
 		return fmt.Errorf("Error deleting Provisioning Hook: %s", err)
 	}
 
@@ -74,9 +74,8 @@ func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	keys, err := services.GetAccountService(sess).
-		Filter(filter.Path("sshKeys.fingerprint").Eq(fingerprint).Build()).
-		GetSshKeys()
+	keys, err := getAccountSshKeys(services.GetAccountService(sess).
+		Filter(filter.Path("sshKeys.fingerprint").Eq(fingerprint).Build()))
 	if err == nil && len(keys) > 0 {
 		slKey := keys[0]
 		id := *slKey.Id
@@ -188,7 +187,7 @@ func resourceIBMComputeSSHKeyDelete(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[INFO] Deleting SSH key: %d", id)
 	_, err = service.Id(id).DeleteObject()
-	if err != nil {
+	if err != nil && !isSoftLayerNotFound(err) {
 		return fmt.Errorf("Error deleting SSH key: %s", err)
 	}
 
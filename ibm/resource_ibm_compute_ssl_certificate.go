@@ -170,7 +170,7 @@ func resourceIBMComputeSSLCertificateDelete(d *schema.ResourceData, meta interfa
 
 	_, err := service.Id(d.Get("id").(int)).DeleteObject()
 
-	if err != nil {
+	if err != nil && !isSoftLayerNotFound(err) {
 		return fmt.Errorf("Error deleting Security Certificate %s: %s", d.Get("id"), err)
 	}
 
@@ -463,7 +463,7 @@ func resourceIBMComputeUserDelete(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Deleting IBM Cloud user: %d", id)
 	_, err := service.Id(id).EditObject(&user)
-	if err != nil {
+	if err != nil && !isSoftLayerNotFound(err) {
 		return fmt.Errorf("Error deleting IBM Cloud user: %s", err)
 	}
 
@@ -1,12 +1,17 @@
 package ibm
 
 import (
+	"bytes"
+	"compress/gzip"
 	"crypto/rand"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -62,7 +67,9 @@ func resourceIBMComputeVmInstance() *schema.Resource {
 		Update:   resourceIBMComputeVmInstanceUpdate,
 		Delete:   resourceIBMComputeVmInstanceDelete,
 		Exists:   resourceIBMComputeVmInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMComputeVmInstanceImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"hostname": {
@@ -143,6 +150,34 @@ func resourceIBMComputeVmInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"evault": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 12000),
+			},
+
+			"evault_order_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"evault_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"evault_username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"evault_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"public_vlan_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -206,11 +241,42 @@ func resourceIBMComputeVmInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"os_username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"ip_address_id_private": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
 
+			"public_interface_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"private_interface_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"public_subnet_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"private_subnet_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
 			"ipv6_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -218,6 +284,13 @@ func resourceIBMComputeVmInstance() *schema.Resource {
 				Default:  false,
 			},
 
+			"ipv6_static_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"ipv6_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -254,6 +327,11 @@ func resourceIBMComputeVmInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"secondary_subnet": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"ssh_key_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -283,8 +361,15 @@ func resourceIBMComputeVmInstance() *schema.Resource {
 				},
 			},
 			"user_metadata": {
-				Type:     schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentUserMetadata,
+			},
+
+			"user_metadata_gzip": {
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
 			},
 
 			"notes": {
@@ -325,6 +410,17 @@ func resourceIBMComputeVmInstance() *schema.Resource {
 				Optional: true,
 				Default:  90,
 			},
+
+			"upgrade_maintenance_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Timestamp,
+			},
+
+			"pending_upgrade_maintenance_window": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -501,9 +597,13 @@ func getVirtualGuestTemplateFromResourceData(d *schema.ResourceData, meta interf
 	}
 
 	if userData, ok := d.GetOk("user_metadata"); ok {
+		value, err := encodeUserMetadata(userData.(string), d.Get("user_metadata_gzip").(bool))
+		if err != nil {
+			return opts, fmt.Errorf("Error creating virtual guest: %s", err)
+		}
 		opts.UserData = []datatypes.Virtual_Guest_Attribute{
 			{
-				Value: sl.String(userData.(string)),
+				Value: sl.String(value),
 			},
 		}
 	}
@@ -578,23 +678,24 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Unable to configure a public IPv6 address with a private_network_only option")
 		}
 
-		ipv6Items, err := services.GetProductPackageService(sess).
-			Id(*template.PackageId).
-			Mask("id,capacity,description,units,keyName,prices[id,categories[id,name,categoryCode]]").
-			Filter(filter.Build(filter.Path("items.keyName").Eq("1_IPV6_ADDRESS"))).
-			GetItems()
+		price, err := getVirtualGuestItemPrice(sess, *template.PackageId, "1_IPV6_ADDRESS")
 		if err != nil {
-			return fmt.Errorf("Error generating order template: %s", err)
+			return err
 		}
-		if len(ipv6Items) == 0 {
-			return fmt.Errorf("No product items matching 1_IPV6_ADDRESS could be found")
+		template.Prices = append(template.Prices, price)
+	}
+
+	// Add a block of static IPv6 addresses routed to the primary IPv6 address
+	if d.Get("ipv6_static_enabled").(bool) {
+		if !d.Get("ipv6_enabled").(bool) {
+			return fmt.Errorf("ipv6_enabled must be set to true to configure static IPv6 addresses with ipv6_static_enabled")
 		}
 
-		template.Prices = append(template.Prices,
-			datatypes.Product_Item_Price{
-				Id: ipv6Items[0].Prices[0].Id,
-			},
-		)
+		price, err := getVirtualGuestItemPrice(sess, *template.PackageId, "64_BLOCK_STATIC_PUBLIC_IPV6_ADDRESSES")
+		if err != nil {
+			return err
+		}
+		template.Prices = append(template.Prices, price)
 	}
 
 	// Configure secondary IPs
@@ -603,23 +704,12 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 		if privateNetworkOnly {
 			return fmt.Errorf("Unable to configure public secondary addresses with a private_network_only option")
 		}
-		staticIPItems, err := services.GetProductPackageService(sess).
-			Id(*template.PackageId).
-			Mask("id,capacity,description,units,keyName,prices[id,categories[id,name,categoryCode]]").
-			Filter(filter.Build(filter.Path("items.keyName").Eq(strconv.Itoa(secondaryIPCount) + "_PUBLIC_IP_ADDRESSES"))).
-			GetItems()
+
+		price, err := getVirtualGuestItemPrice(sess, *template.PackageId, strconv.Itoa(secondaryIPCount)+"_PUBLIC_IP_ADDRESSES")
 		if err != nil {
-			return fmt.Errorf("Error generating order template: %s", err)
-		}
-		if len(staticIPItems) == 0 {
-			return fmt.Errorf("No product items matching %d_PUBLIC_IP_ADDRESSES could be found", secondaryIPCount)
+			return err
 		}
-
-		template.Prices = append(template.Prices,
-			datatypes.Product_Item_Price{
-				Id: staticIPItems[0].Prices[0].Id,
-			},
-		)
+		template.Prices = append(template.Prices, price)
 	}
 
 	// GenerateOrderTemplate omits UserData, subnet, and maxSpeed, so configure virtual_guest.
@@ -629,8 +719,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 		Container_Product_Order_Hardware_Server: datatypes.Container_Product_Order_Hardware_Server{Container_Product_Order: template},
 	}
 
-	orderService := services.GetProductOrderService(sess)
-	receipt, err := orderService.PlaceOrder(order, sl.Bool(false))
+	receipt, err := placeOrder(meta, template.PackageId, order)
 	if err != nil {
 		return fmt.Errorf("Error ordering virtual guest: %s", err)
 	}
@@ -680,6 +769,23 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 			"Error waiting for virtual machine (%s) to become ready: %s", d.Id(), err)
 	}
 
+	// Order the EVault backup storage once the guest is available. The order is saved as soon as
+	// it is placed, the storage which is not provisioned in time is adopted by the next refresh.
+	if capacity, ok := d.GetOk("evault"); ok {
+		orderID, err := placeEvaultOrder(meta, d.Get("datacenter").(string), capacity.(int), id, 0)
+		if err != nil {
+			return err
+		}
+		d.Set("evault_order_id", orderID)
+
+		evault, err := findEvaultByOrderId(meta.(ClientSession).SoftLayerSession(), orderID)
+		if err != nil {
+			logPendingOrderWait("EVault storage", orderID, err)
+		} else {
+			d.Set("evault_id", *evault.Id)
+		}
+	}
+
 	return resourceIBMComputeVmInstanceRead(d, meta)
 }
 
@@ -699,11 +805,12 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 			"notes,userData[value],tagReferences[id,tag[name]]," +
 			"datacenter[id,name,longName]," +
 			"sshKeys," +
-			"primaryNetworkComponent[networkVlan[id]," +
+			"primaryNetworkComponent[id,networkVlan[id]," +
 			"primaryVersion6IpAddressRecord[subnet,guestNetworkComponentBinding[ipAddressId]]," +
 			"primaryIpAddressRecord[subnet,guestNetworkComponentBinding[ipAddressId]]]," +
-			"primaryBackendNetworkComponent[networkVlan[id]," +
-			"primaryIpAddressRecord[subnet,guestNetworkComponentBinding[ipAddressId]]]",
+			"primaryBackendNetworkComponent[id,networkVlan[id]," +
+			"primaryIpAddressRecord[subnet,guestNetworkComponentBinding[ipAddressId]]]," +
+			"upgradeRequest[completedFlag,maintenanceStartTimeUtc]",
 	).GetObject()
 
 	if err != nil {
@@ -728,16 +835,23 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 		d.Set("datacenter", *result.Datacenter.Name)
 	}
 
-	d.Set(
-		"network_speed",
-		sl.Grab(
-			result,
-			"PrimaryBackendNetworkComponent.MaxSpeed",
-			d.Get("network_speed").(int),
-		),
-	)
-	d.Set("cores", *result.StartCpus)
-	d.Set("memory", *result.MaxMemory)
+	// While an upgrade is scheduled in a maintenance window, keep the requested size so that the
+	// upgrade is not ordered again
+	if result.UpgradeRequest != nil && !sl.Get(result.UpgradeRequest.CompletedFlag, false).(bool) {
+		d.Set("pending_upgrade_maintenance_window", sl.Get(result.UpgradeRequest.MaintenanceStartTimeUtc, datatypes.Time{}).(datatypes.Time).UTC().Format(time.RFC3339))
+	} else {
+		d.Set("pending_upgrade_maintenance_window", "")
+		d.Set(
+			"network_speed",
+			sl.Grab(
+				result,
+				"PrimaryBackendNetworkComponent.MaxSpeed",
+				d.Get("network_speed").(int),
+			),
+		)
+		d.Set("cores", *result.StartCpus)
+		d.Set("memory", *result.MaxMemory)
+	}
 	d.Set("dedicated_acct_host_only", *result.DedicatedAccountHostOnlyFlag)
 	if result.PrimaryIpAddress != nil {
 		d.Set("has_public_ip", *result.PrimaryIpAddress != "")
@@ -759,12 +873,16 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("private_vlan_id", *result.PrimaryBackendNetworkComponent.NetworkVlan.Id)
 
+	d.Set("public_interface_id", sl.Grab(result, "PrimaryNetworkComponent.Id", 0))
+	d.Set("private_interface_id", sl.Grab(result, "PrimaryBackendNetworkComponent.Id", 0))
+
 	if result.PrimaryNetworkComponent.PrimaryIpAddressRecord != nil {
 		publicSubnet := result.PrimaryNetworkComponent.PrimaryIpAddressRecord.Subnet
 		d.Set(
 			"public_subnet",
 			fmt.Sprintf("%s/%d", *publicSubnet.NetworkIdentifier, *publicSubnet.Cidr),
 		)
+		d.Set("public_subnet_id", sl.Get(publicSubnet.Id, 0))
 	}
 
 	privateSubnet := result.PrimaryBackendNetworkComponent.PrimaryIpAddressRecord.Subnet
@@ -772,6 +890,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 		"private_subnet",
 		fmt.Sprintf("%s/%d", *privateSubnet.NetworkIdentifier, *privateSubnet.Cidr),
 	)
+	d.Set("private_subnet_id", sl.Get(privateSubnet.Id, 0))
 
 	d.Set("ipv6_enabled", false)
 	if result.PrimaryNetworkComponent.PrimaryVersion6IpAddressRecord != nil {
@@ -792,15 +911,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("notes", sl.Get(result.Notes, nil))
 
-	tagReferences := result.TagReferences
-	tagReferencesLen := len(tagReferences)
-	if tagReferencesLen > 0 {
-		tags := make([]string, 0, tagReferencesLen)
-		for _, tagRef := range tagReferences {
-			tags = append(tags, *tagRef.Tag.Name)
-		}
-		d.Set("tags", tags)
-	}
+	d.Set("tags", flattenTagReferences(result.TagReferences))
 
 	storages := result.AllowedNetworkStorage
 	d.Set("block_storage_ids", flattenBlockStorageID(storages))
@@ -818,13 +929,68 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	} else {
 		connInfo["host"] = *result.PrimaryBackendIpAddress
 	}
+	// The credentials of the operating system are only looked up when the server is created or
+	// imported, so that the password is not read again on every refresh
+	if d.IsNewResource() || d.Get("os_username").(string) == "" {
+		operatingSystem, err := service.Id(id).Mask(osPasswordsMask).GetOperatingSystem()
+		if err != nil {
+			log.Printf("[WARN] Error retrieving the operating system credentials of virtual guest %d: %s", id, err)
+		} else {
+			username, password := flattenOSCredentials(operatingSystem.Passwords)
+			d.Set("os_username", username)
+			d.Set("os_password", password)
+		}
+	}
+	if username := d.Get("os_username").(string); username != "" {
+		connInfo["user"] = username
+		connInfo["password"] = d.Get("os_password").(string)
+	}
 	d.SetConnInfo(connInfo)
 
-	// Read secondary IP addresses
+	// Read the EVault backup storage ordered with the guest. The storage which was not provisioned
+	// when the guest was created is looked up by its order.
+	evaultID := d.Get("evault_id").(int)
+	if orderID := d.Get("evault_order_id").(int); evaultID == 0 && orderID != 0 {
+		evault, found, err := getEvaultByOrderId(meta.(ClientSession).SoftLayerSession(), orderID)
+		if err != nil {
+			log.Printf("[WARN] Error retrieving the EVault storage of order %d: %s", orderID, err)
+		} else if found {
+			evaultID = *evault.Id
+			d.Set("evault_id", evaultID)
+		}
+	}
+	if evaultID != 0 {
+		evault, err := services.GetNetworkStorageBackupEvaultService(meta.(ClientSession).SoftLayerSession()).
+			Id(evaultID).Mask("id,username,password,capacityGb").GetObject()
+		if isSoftLayerNotFound(err) {
+			// The guest is not replaced to order the storage again, it keeps running without backups
+			log.Printf("[WARN] The EVault storage %d of virtual guest %d was deleted outside of Terraform, it is not ordered again", evaultID, id)
+			d.Set("evault_order_id", 0)
+			d.Set("evault_id", 0)
+			d.Set("evault_username", "")
+			d.Set("evault_password", "")
+		} else if err != nil {
+			log.Printf("[WARN] Error retrieving the EVault storage of virtual guest %d: %s", id, err)
+		} else {
+			d.Set("evault", sl.Get(evault.CapacityGb, 0))
+			d.Set("evault_username", sl.Get(evault.Username, ""))
+			d.Set("evault_password", sl.Get(evault.Password, ""))
+		}
+	}
+
+	// Read secondary IP addresses. When skip_detailed_refresh is set on the provider, they are
+	// only looked up until they are known.
+	if meta.(ClientSession).SkipDetailedRefresh() {
+		if _, ok := d.GetOk("secondary_ip_addresses"); ok || d.Get("secondary_ip_count").(int) == 0 {
+			return nil
+		}
+	}
+
 	d.Set("secondary_ip_addresses", nil)
+	d.Set("secondary_subnet", "")
 	if result.PrimaryIpAddress != nil {
 		secondarySubnetResult, err := services.GetAccountService(meta.(ClientSession).SoftLayerSession()).
-			Mask("ipAddresses[id,ipAddress],subnetType").
+			Mask("networkIdentifier,cidr,ipAddresses[id,ipAddress],subnetType").
 			Filter(filter.Build(filter.Path("publicSubnets.endPointIpAddress.ipAddress").Eq(*result.PrimaryIpAddress))).
 			GetPublicSubnets()
 		if err != nil {
@@ -835,6 +1001,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 		for _, subnet := range secondarySubnetResult {
 			// Count static secondary ip addresses.
 			if *subnet.SubnetType == staticIPRouted {
+				d.Set("secondary_subnet", fmt.Sprintf("%s/%d", sl.Get(subnet.NetworkIdentifier, ""), sl.Get(subnet.Cidr, 0)))
 				for _, ipAddressObj := range subnet.IpAddresses {
 					secondaryIps = append(secondaryIps, *ipAddressObj.IpAddress)
 				}
@@ -886,8 +1053,12 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	// Set user data if provided and not empty
-	if d.HasChange("user_metadata") {
-		_, err := service.Id(id).SetUserMetadata([]string{d.Get("user_metadata").(string)})
+	if d.HasChange("user_metadata") || d.HasChange("user_metadata_gzip") {
+		value, err := encodeUserMetadata(d.Get("user_metadata").(string), d.Get("user_metadata_gzip").(bool))
+		if err != nil {
+			return err
+		}
+		_, err = service.Id(id).SetUserMetadata([]string{value})
 		if err != nil {
 			return fmt.Errorf("Couldn't update user data for virtual guest: %s", err)
 		}
@@ -926,18 +1097,34 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	if len(upgradeOptions) > 0 {
-		_, err = virtual.UpgradeVirtualGuest(sess, &result, upgradeOptions)
+		upgradeTime := time.Now()
+		if window, ok := d.GetOk("upgrade_maintenance_window"); ok {
+			// Already validated by the schema
+			upgradeTime, _ = time.Parse(time.RFC3339, window.(string))
+		}
+
+		_, err = virtual.UpgradeVirtualGuest(sess, &result, upgradeOptions, upgradeTime)
 		if err != nil {
 			return fmt.Errorf("Couldn't upgrade virtual guest: %s", err)
 		}
 
+		// An upgrade scheduled in a future maintenance window is only reported as pending
+		if upgradeTime.After(time.Now()) {
+			log.Printf("[INFO] Upgrade of virtual guest %d scheduled at %s", id, upgradeTime.UTC().Format(time.RFC3339))
+			return resourceIBMComputeVmInstanceRead(d, meta)
+		}
+
 		// Wait for softlayer to start upgrading...
 		_, err = WaitForUpgradeTransactionsToAppear(d, meta)
+		if err != nil {
+			return fmt.Errorf("Error waiting for the upgrade of virtual guest %d to start: %s", id, err)
+		}
 
 		// Wait for upgrade transactions to finish
 		_, err = WaitForNoActiveTransactions(d, meta)
-
-		return err
+		if err != nil {
+			return fmt.Errorf("Error waiting for the upgrade of virtual guest %d to complete: %s", id, err)
+		}
 	}
 
 	return resourceIBMComputeVmInstanceRead(d, meta)
@@ -985,6 +1172,12 @@ func resourceIBMComputeVmInstanceDelete(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
 	}
 
+	_, err = service.Id(id).Mask("id").GetObject()
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The virtual guest %d was already deleted", id)
+		return cancelVirtualGuestEvault(d, meta)
+	}
+
 	_, err = WaitForNoActiveTransactions(d, meta)
 
 	if err != nil {
@@ -992,17 +1185,41 @@ func resourceIBMComputeVmInstanceDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	ok, err := service.Id(id).DeleteObject()
-
-	if err != nil {
+	if err != nil && !isSoftLayerNotFound(err) {
 		return fmt.Errorf("Error deleting virtual guest: %s", err)
 	}
 
-	if !ok {
+	if err == nil && !ok {
 		return fmt.Errorf(
 			"API reported it was unsuccessful in removing the virtual guest '%d'", id)
 	}
 
-	return nil
+	// The EVault storage is only cancelled once the guest is deleted, so that a guest which fails
+	// to be deleted keeps its backups
+	return cancelVirtualGuestEvault(d, meta)
+}
+
+// cancelVirtualGuestEvault cancels the EVault backup storage ordered with the guest. The storage
+// is looked up by its order when the guest was created before it was provisioned.
+func cancelVirtualGuestEvault(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	evaultID := d.Get("evault_id").(int)
+	if orderID := d.Get("evault_order_id").(int); evaultID == 0 && orderID != 0 {
+		evault, found, err := getEvaultByOrderId(sess, orderID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the EVault storage of order %d: %s", orderID, err)
+		}
+		if !found {
+			log.Printf("[WARN] The EVault storage of order %d was not provisioned, it must be cancelled manually once it is", orderID)
+			return nil
+		}
+		evaultID = *evault.Id
+	}
+	if evaultID == 0 {
+		return nil
+	}
+	return cancelEvault(sess, evaultID)
 }
 
 //genID generates a random string to be used for the optional
@@ -1268,3 +1485,152 @@ func setNotes(id int, d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// userMetadataMaxSize is the largest user metadata accepted by a virtual guest, in bytes
+const userMetadataMaxSize = 64 * 1024
+
+// encodeUserMetadata returns the user metadata to set on a virtual guest. It is compressed with gzip
+// and encoded in base64 when gzipped is set, which cloud-init decodes on boot.
+func encodeUserMetadata(userMetadata string, gzipped bool) (string, error) {
+	if gzipped && !isGzipBase64(userMetadata) {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write([]byte(userMetadata)); err != nil {
+			return "", fmt.Errorf("Error compressing user metadata: %s", err)
+		}
+		if err := w.Close(); err != nil {
+			return "", fmt.Errorf("Error compressing user metadata: %s", err)
+		}
+		userMetadata = base64.StdEncoding.EncodeToString(buf.Bytes())
+	}
+
+	if len(userMetadata) > userMetadataMaxSize {
+		return "", fmt.Errorf("The user metadata is %d bytes, it must not exceed %d bytes. Set user_metadata_gzip to compress it.",
+			len(userMetadata), userMetadataMaxSize)
+	}
+	return userMetadata, nil
+}
+
+// decodeUserMetadata returns the user metadata decoded from gzip and base64, or unchanged when it
+// is not encoded
+func decodeUserMetadata(userMetadata string) string {
+	data, err := base64.StdEncoding.DecodeString(userMetadata)
+	if err != nil {
+		return userMetadata
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return userMetadata
+	}
+	defer r.Close()
+	decoded, err := ioutil.ReadAll(r)
+	if err != nil {
+		return userMetadata
+	}
+	return string(decoded)
+}
+
+func isGzipBase64(userMetadata string) bool {
+	return userMetadata != "" && decodeUserMetadata(userMetadata) != userMetadata
+}
+
+// suppressEquivalentUserMetadata ignores the difference between the user metadata and its gzip and
+// base64 encoding, since the encoded user metadata is read back from the virtual guest
+func suppressEquivalentUserMetadata(k, old, new string, d *schema.ResourceData) bool {
+	return decodeUserMetadata(old) == decodeUserMetadata(new)
+}
+
+// osPasswordsMask is the mask of the credentials of the operating system of a server
+const osPasswordsMask = "passwords[username,password]"
+
+// flattenOSCredentials returns the credentials of the administrator of the operating system, or
+// the first credentials when there is no administrator
+func flattenOSCredentials(passwords []datatypes.Software_Component_Password) (string, string) {
+	for _, p := range passwords {
+		username := sl.Get(p.Username, "").(string)
+		if username == "root" || username == "Administrator" {
+			return username, sl.Get(p.Password, "").(string)
+		}
+	}
+	if len(passwords) > 0 {
+		return sl.Get(passwords[0].Username, "").(string), sl.Get(passwords[0].Password, "").(string)
+	}
+	return "", ""
+}
+
+// getVirtualGuestItemPrice returns the price of the item of the package with the key name, to add
+// it to the order of a virtual guest
+func getVirtualGuestItemPrice(sess *session.Session, packageID int, keyName string) (datatypes.Product_Item_Price, error) {
+	items, err := services.GetProductPackageService(sess).
+		Id(packageID).
+		Mask("id,capacity,description,units,keyName,prices[id,categories[id,name,categoryCode]]").
+		Filter(filter.Build(filter.Path("items.keyName").Eq(keyName))).
+		GetItems()
+	if err != nil {
+		return datatypes.Product_Item_Price{}, fmt.Errorf("Error generating order template: %s", err)
+	}
+	if len(items) == 0 || len(items[0].Prices) == 0 {
+		return datatypes.Product_Item_Price{}, fmt.Errorf("No product items matching %s could be found", keyName)
+	}
+	return datatypes.Product_Item_Price{Id: items[0].Prices[0].Id}, nil
+}
+
+// resourceIBMComputeVmInstanceImportState imports a virtual guest by its ID, or by its fully
+// qualified domain name when the ID is not numeric
+func resourceIBMComputeVmInstanceImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	hostname, domain, err := parseComputeFQDN(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	guests, err := getAccountVirtualGuests(services.GetAccountService(meta.(ClientSession).SoftLayerSession()).
+		Mask("id").
+		Filter(filter.Build(
+			filter.Path("virtualGuests.hostname").Eq(hostname),
+			filter.Path("virtualGuests.domain").Eq(domain),
+		)))
+	if err != nil {
+		return nil, fmt.Errorf("Error looking up virtual guest %s: %s", d.Id(), err)
+	}
+
+	ids := make([]int, 0, len(guests))
+	for _, guest := range guests {
+		ids = append(ids, *guest.Id)
+	}
+	id, err := selectComputeImportID("virtual guest", d.Id(), ids)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(strconv.Itoa(id))
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseComputeFQDN splits the fully qualified domain name of a server into its hostname and domain
+func parseComputeFQDN(fqdn string) (string, string, error) {
+	parts := strings.SplitN(fqdn, ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Not a valid ID, must be an integer or a fully qualified domain name such as <hostname>.<domain>: %s", fqdn)
+	}
+	return parts[0], parts[1], nil
+}
+
+// selectComputeImportID returns the ID of the only server with the fully qualified domain name, or
+// an error listing the candidate IDs when the name is ambiguous
+func selectComputeImportID(kind, fqdn string, ids []int) (int, error) {
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("No %s found with hostname and domain %s", kind, fqdn)
+	case 1:
+		return ids[0], nil
+	}
+	sort.Ints(ids)
+	candidates := make([]string, 0, len(ids))
+	for _, id := range ids {
+		candidates = append(candidates, strconv.Itoa(id))
+	}
+	return 0, fmt.Errorf("%d %ss found with hostname and domain %s, import one of them by ID: %s",
+		len(ids), kind, fqdn, strings.Join(candidates, ", "))
+}
@@ -8,12 +8,14 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
 )
 
 func init() {
@@ -96,6 +98,10 @@ func TestAccIBMComputeVmInstance_basic(t *testing.T) {
 						configInstance, "secondary_ip_count", "4"),
 					resource.TestCheckResourceAttrSet(
 						configInstance, "secondary_ip_addresses.3"),
+					resource.TestCheckResourceAttrSet(
+						configInstance, "secondary_subnet"),
+					resource.TestCheckResourceAttr(
+						configInstance, "ipv6_static_enabled", "true"),
 					resource.TestCheckResourceAttr(
 						configInstance, "notes", "VM notes"),
 				),
@@ -190,6 +196,14 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 				ImportStateVerifyIgnore: []string{
 					"wait_time_minutes", "disks.#", "disks.0"},
 			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     hostname + "." + domain,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"wait_time_minutes", "disks.#", "disks.0"},
+			},
 		},
 	})
 }
@@ -334,6 +348,55 @@ func TestAccIBMComputeVmInstance_With_Network_Storage_Access(t *testing.T) {
 	})
 }
 
+func TestAccIBMComputeVmInstance_UpgradeMaintenanceWindow(t *testing.T) {
+	var guest datatypes.Virtual_Guest
+
+	hostname := acctest.RandString(16)
+	domain := "terraformvmuat.ibm.com"
+	window := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccIBMComputeVmInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIBMComputeVmInstanceConfigUpgradeMaintenanceWindow(hostname, domain, "1", window),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIBMComputeVmInstanceExists("ibm_compute_vm_instance.terraform-acceptance-test-1", &guest),
+					resource.TestCheckResourceAttr(
+						"ibm_compute_vm_instance.terraform-acceptance-test-1", "pending_upgrade_maintenance_window", ""),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccIBMComputeVmInstanceConfigUpgradeMaintenanceWindow(hostname, domain, "2", window),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_compute_vm_instance.terraform-acceptance-test-1", "cores", "2"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_compute_vm_instance.terraform-acceptance-test-1", "pending_upgrade_maintenance_window"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMComputeVmInstance_InvalidUpgradeMaintenanceWindow(t *testing.T) {
+	hostname := acctest.RandString(16)
+	domain := "terraformvmuat.ibm.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccIBMComputeVmInstanceConfigUpgradeMaintenanceWindow(hostname, domain, "1", "tomorrow"),
+				ExpectError: regexp.MustCompile("must be a timestamp in RFC 3339 format"),
+			},
+		},
+	})
+}
+
 func testAccIBMComputeVmInstanceDestroy(s *terraform.State) error {
 	service := services.GetVirtualGuestService(testAccProvider.Meta().(ClientSession).SoftLayerSession())
 
@@ -451,6 +514,7 @@ resource "ibm_compute_vm_instance" "terraform-acceptance-test-1" {
     dedicated_acct_host_only = true
     local_disk = false
     ipv6_enabled = true
+    ipv6_static_enabled = true
     secondary_ip_count = 4
     notes = "VM notes"
 }`, hostname, domain, networkSpeed, cores, memory, userMetadata, tags)
@@ -479,6 +543,22 @@ resource "ibm_compute_vm_instance" "terraform-acceptance-test-1" {
 }`, hostname, domain, networkSpeed, cores, memory, userMetadata, tags)
 }
 
+func testAccIBMComputeVmInstanceConfigUpgradeMaintenanceWindow(hostname, domain, cores, window string) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_vm_instance" "terraform-acceptance-test-1" {
+    hostname = "%s"
+    domain = "%s"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "wdc04"
+    network_speed = 10
+    hourly_billing = true
+    cores = %s
+    memory = 1024
+    local_disk = false
+    upgrade_maintenance_window = "%s"
+}`, hostname, domain, cores, window)
+}
+
 func testAccIBMComputeVmInstanceConfigPostInstallScriptURI(hostname, domain string) string {
 	return fmt.Sprintf(`
 resource "ibm_compute_vm_instance" "terraform-acceptance-test-pISU" {
@@ -625,3 +705,102 @@ resource "ibm_compute_vm_instance" "terraform-ssh-key" {
 `, hostname, domain)
 	return
 }
+
+func TestEncodeUserMetadata(t *testing.T) {
+	userMetadata := "#cloud-config\npackages:\n  - nginx\n"
+
+	plain, err := encodeUserMetadata(userMetadata, false)
+	if err != nil || plain != userMetadata {
+		t.Fatalf("Expected the user metadata unchanged, got %q: %v", plain, err)
+	}
+
+	encoded, err := encodeUserMetadata(userMetadata, true)
+	if err != nil {
+		t.Fatalf("Error encoding user metadata: %s", err)
+	}
+	if encoded == userMetadata || decodeUserMetadata(encoded) != userMetadata {
+		t.Errorf("Expected the user metadata to be encoded with gzip and base64, got %q", encoded)
+	}
+
+	reencoded, err := encodeUserMetadata(encoded, true)
+	if err != nil || reencoded != encoded {
+		t.Errorf("Expected encoded user metadata to be kept, got %q: %v", reencoded, err)
+	}
+
+	if !suppressEquivalentUserMetadata("user_metadata", encoded, userMetadata, nil) {
+		t.Errorf("Expected the encoded user metadata to be equivalent to %q", userMetadata)
+	}
+	if suppressEquivalentUserMetadata("user_metadata", encoded, "#cloud-config\n", nil) {
+		t.Errorf("Expected different user metadata not to be equivalent")
+	}
+
+	_, err = encodeUserMetadata(strings.Repeat("a", userMetadataMaxSize+1), false)
+	if err == nil {
+		t.Errorf("Expected an error for user metadata larger than %d bytes", userMetadataMaxSize)
+	}
+	_, err = encodeUserMetadata(strings.Repeat("a", userMetadataMaxSize+1), true)
+	if err != nil {
+		t.Errorf("Expected compressed user metadata to fit, got %s", err)
+	}
+}
+
+func TestFlattenOSCredentials(t *testing.T) {
+	testCases := []struct {
+		passwords []datatypes.Software_Component_Password
+		username  string
+		password  string
+	}{
+		{nil, "", ""},
+		{
+			[]datatypes.Software_Component_Password{
+				{Username: sl.String("ubuntu"), Password: sl.String("secret1")},
+				{Username: sl.String("root"), Password: sl.String("secret2")},
+			},
+			"root", "secret2",
+		},
+		{
+			[]datatypes.Software_Component_Password{
+				{Username: sl.String("Administrator"), Password: sl.String("secret3")},
+			},
+			"Administrator", "secret3",
+		},
+		{
+			[]datatypes.Software_Component_Password{
+				{Username: sl.String("ubuntu"), Password: sl.String("secret4")},
+			},
+			"ubuntu", "secret4",
+		},
+	}
+
+	for _, tc := range testCases {
+		username, password := flattenOSCredentials(tc.passwords)
+		if username != tc.username || password != tc.password {
+			t.Errorf("Expected credentials %s/%s, got %s/%s", tc.username, tc.password, username, password)
+		}
+	}
+}
+
+func TestParseComputeFQDN(t *testing.T) {
+	hostname, domain, err := parseComputeFQDN("web01.example.com")
+	if err != nil || hostname != "web01" || domain != "example.com" {
+		t.Errorf("Expected web01 and example.com, got %s, %s and %v", hostname, domain, err)
+	}
+	for _, fqdn := range []string{"web01", "web01.", ".example.com"} {
+		if _, _, err := parseComputeFQDN(fqdn); err == nil {
+			t.Errorf("Expected an error for %s", fqdn)
+		}
+	}
+}
+
+func TestSelectComputeImportID(t *testing.T) {
+	if id, err := selectComputeImportID("virtual guest", "web01.example.com", []int{42}); err != nil || id != 42 {
+		t.Errorf("Expected 42, got %d and %v", id, err)
+	}
+	if _, err := selectComputeImportID("virtual guest", "web01.example.com", nil); err == nil {
+		t.Errorf("Expected an error when no virtual guest matches")
+	}
+	_, err := selectComputeImportID("virtual guest", "web01.example.com", []int{43, 41})
+	if err == nil || !strings.Contains(err.Error(), "2 virtual guests found") || !strings.Contains(err.Error(), "41, 43") {
+		t.Errorf("Expected an error listing the candidate IDs, got %v", err)
+	}
+}
@@ -60,6 +60,13 @@ func resourceIBMContainerBindService() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"region": {
+				Description:  "The region of the container service, which defaults to the region of the provider",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateContainerRegion,
+			},
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -83,8 +90,14 @@ func getClusterTargetHeader(d *schema.ResourceData) v1.ClusterTargetHeader {
 	return targetEnv
 }
 
+// containerAPI provides the Container Service APIs of the region of the resource, or of the
+// provider when the resource has no region
+func containerAPI(d *schema.ResourceData, meta interface{}) (v1.ContainerServiceAPI, error) {
+	return meta.(ClientSession).ContainerRegionAPI(d.Get("region").(string))
+}
+
 func resourceIBMContainerBindServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return err
 	}
@@ -126,7 +139,7 @@ func resourceIBMContainerBindServiceRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceIBMContainerBindServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return err
 	}
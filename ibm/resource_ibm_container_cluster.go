@@ -135,8 +135,9 @@ func resourceIBMContainerCluster() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"level": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"Normal", "Warning", "Critical"}),
 						},
 						"type": {
 							Type:         schema.TypeString,
@@ -168,6 +169,13 @@ func resourceIBMContainerCluster() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"region": {
+				Description:  "The region of the container service, which defaults to the region of the provider",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateContainerRegion,
+			},
 			"wait_time_minutes": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -184,7 +192,7 @@ func resourceIBMContainerCluster() *schema.Resource {
 }
 
 func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return err
 	}
@@ -248,15 +256,11 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 	}
 	whkAPI := csClient.WebHooks()
 	for _, e := range webhooks {
-		pack := e.(map[string]interface{})
-		webhook := v1.WebHook{
-			Level: pack["level"].(string),
-			Type:  pack["type"].(string),
-			URL:   pack["url"].(string),
+		webhook := expandClusterWebhook(e.(map[string]interface{}))
+		err = whkAPI.Add(cls.ID, webhook, targetEnv)
+		if err != nil {
+			return fmt.Errorf("Error adding %s webhook to cluster (%s): %s", webhook.Type, cls.ID, err)
 		}
-
-		whkAPI.Add(cls.ID, webhook, targetEnv)
-
 	}
 
 	workersInfo := []map[string]string{}
@@ -286,7 +290,7 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return err
 	}
@@ -305,11 +309,17 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("ingress_secret", cls.IngressSecretName)
 	d.Set("worker_num", cls.WorkerCount)
 	d.Set("subnet_id", d.Get("subnet_id").(*schema.Set))
+
+	webhooks, err := csClient.WebHooks().List(clusterID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error retrieving webhooks of cluster (%s): %s", clusterID, err)
+	}
+	d.Set("webhook", flattenClusterWebhooks(d.Get("webhook").([]interface{}), webhooks))
 	return nil
 }
 
 func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return err
 	}
@@ -393,28 +403,24 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		d.Set("workers", workersInfo)
 	}
 
-	//TODO put webhooks can't deleted in the error message if such case is observed in the chnages
 	if d.HasChange("webhook") {
 		oldHooks, newHooks := d.GetChange("webhook")
 		oldHook := oldHooks.([]interface{})
 		newHook := newHooks.([]interface{})
-		for _, nH := range newHook {
-			newPack := nH.(map[string]interface{})
-			exists := false
-			for _, oH := range oldHook {
-				oldPack := oH.(map[string]interface{})
-				if (strings.Compare(newPack["level"].(string), oldPack["level"].(string)) == 0) && (strings.Compare(newPack["type"].(string), oldPack["type"].(string)) == 0) && (strings.Compare(newPack["url"].(string), oldPack["url"].(string)) == 0) {
-					exists = true
-				}
+		// The API cannot delete a webhook, so refuse to drop one from the configuration
+		for _, oH := range oldHook {
+			webhook := expandClusterWebhook(oH.(map[string]interface{}))
+			if !containsClusterWebhook(newHook, webhook) {
+				return fmt.Errorf("The %s webhook %s of cluster (%s) cannot be deleted", webhook.Type, webhook.URL, clusterID)
 			}
-			if !exists {
-				webhook := v1.WebHook{
-					Level: newPack["level"].(string),
-					Type:  newPack["type"].(string),
-					URL:   newPack["url"].(string),
+		}
+		for _, nH := range newHook {
+			webhook := expandClusterWebhook(nH.(map[string]interface{}))
+			if !containsClusterWebhook(oldHook, webhook) {
+				err = whkAPI.Add(clusterID, webhook, targetEnv)
+				if err != nil {
+					return fmt.Errorf("Error adding %s webhook to cluster (%s): %s", webhook.Type, clusterID, err)
 				}
-
-				whkAPI.Add(clusterID, webhook, targetEnv)
 			}
 		}
 	}
@@ -452,7 +458,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 
 func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorkers []interface{}, workerInfo []map[string]string) (string, error) {
 	targetEnv := getClusterTargetHeader(d)
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return "", err
 	}
@@ -484,7 +490,7 @@ func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorker
 
 func resourceIBMContainerClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	targetEnv := getClusterTargetHeader(d)
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return err
 	}
@@ -496,9 +502,48 @@ func resourceIBMContainerClusterDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func expandClusterWebhook(pack map[string]interface{}) v1.WebHook {
+	return v1.WebHook{
+		Level: pack["level"].(string),
+		Type:  pack["type"].(string),
+		URL:   pack["url"].(string),
+	}
+}
+
+func containsClusterWebhook(hooks []interface{}, webhook v1.WebHook) bool {
+	for _, h := range hooks {
+		if expandClusterWebhook(h.(map[string]interface{})) == webhook {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenClusterWebhooks returns the configured webhooks which are registered to the cluster.
+// The webhooks registered outside of Terraform are ignored, since they cannot be deleted.
+func flattenClusterWebhooks(configured []interface{}, webhooks []v1.WebHook) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(configured))
+	registered := make(map[v1.WebHook]bool, len(webhooks))
+	for _, webhook := range webhooks {
+		registered[webhook] = true
+	}
+	for _, h := range configured {
+		webhook := expandClusterWebhook(h.(map[string]interface{}))
+		if registered[webhook] {
+			result = append(result, map[string]interface{}{
+				"level": webhook.Level,
+				"type":  webhook.Type,
+				"url":   webhook.URL,
+			})
+			delete(registered, webhook)
+		}
+	}
+	return result
+}
+
 // WaitForClusterAvailable Waits for cluster creation
 func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -536,7 +581,7 @@ func clusterStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.Re
 
 // WaitForWorkerAvailable Waits for worker creation
 func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -575,7 +620,7 @@ func workerStateRefreshFunc(client v1.Workers, instanceID string, d *schema.Reso
 }
 
 func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -608,7 +653,7 @@ func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.Res
 }
 
 func resourceIBMContainerClusterExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	csClient, err := meta.(ClientSession).ContainerAPI()
+	csClient, err := containerAPI(d, meta)
 	if err != nil {
 		return false, err
 	}
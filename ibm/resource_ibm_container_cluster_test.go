@@ -109,14 +109,14 @@ func getClusterTargetHeaderTestACC() v1.ClusterTargetHeader {
 	}
 
 	orgAPI := client.Organizations()
-	myorg, err := orgAPI.FindByName(org, BluemixRegion)
+	myorg, err := orgAPI.FindByName(org, testAccProvider.Meta().(ClientSession).BluemixRegion())
 
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	spaceAPI := client.Spaces()
-	myspace, err := spaceAPI.FindByNameInOrg(myorg.GUID, space, BluemixRegion)
+	myspace, err := spaceAPI.FindByNameInOrg(myorg.GUID, space, testAccProvider.Meta().(ClientSession).BluemixRegion())
 
 	if err != nil {
 		log.Fatal(err)
@@ -127,7 +127,7 @@ func getClusterTargetHeaderTestACC() v1.ClusterTargetHeader {
 		log.Fatal(err)
 	}
 	accountAPI := accClient.Accounts()
-	myAccount, err := accountAPI.FindByOrg(myorg.GUID, c.Region)
+	myAccount, err := accountAPI.FindByOrg(myorg.GUID, testAccProvider.Meta().(ClientSession).BluemixRegion())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -247,3 +247,28 @@ resource "ibm_container_cluster" "testacc_cluster" {
   tags = ["test","once"]
 }	`, cfOrganization, cfOrganization, cfSpace, clusterName, datacenter, machineType, publicVlanID, privateVlanID)
 }
+
+func TestFlattenClusterWebhooks(t *testing.T) {
+	configured := []interface{}{
+		map[string]interface{}{"level": "Warning", "type": "slack", "url": "https://hooks.slack.com/b"},
+		map[string]interface{}{"level": "Normal", "type": "slack", "url": "https://hooks.slack.com/a"},
+		map[string]interface{}{"level": "Normal", "type": "slack", "url": "https://hooks.slack.com/deleted"},
+	}
+	webhooks := []v1.WebHook{
+		{Level: "Normal", Type: "slack", URL: "https://hooks.slack.com/a"},
+		{Level: "Critical", Type: "slack", URL: "https://hooks.slack.com/c"},
+		{Level: "Warning", Type: "slack", URL: "https://hooks.slack.com/b"},
+	}
+
+	// The webhooks registered outside of Terraform are ignored, the deleted ones are dropped
+	result := flattenClusterWebhooks(configured, webhooks)
+	expected := []string{"https://hooks.slack.com/b", "https://hooks.slack.com/a"}
+	if len(result) != len(expected) {
+		t.Fatalf("Expected %d webhooks, got %d", len(expected), len(result))
+	}
+	for i, url := range expected {
+		if result[i]["url"] != url {
+			t.Errorf("Expected webhook %d to be %s, got %s", i, url, result[i]["url"])
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	v1 "github.com/IBM-Bluemix/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Bluemix/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIBMContainerSubnet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMContainerSubnetCreate,
+		Read:   resourceIBMContainerSubnetRead,
+		Delete: resourceIBMContainerSubnetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name_id": {
+				Description: "The name or ID of the cluster",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subnet_id": {
+				Description: "The ID of the portable subnet to add to the cluster",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"org_guid": {
+				Description: "The bluemix organization guid this cluster belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"space_guid": {
+				Description: "The bluemix space guid this cluster belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"account_guid": {
+				Description: "The bluemix account guid this cluster belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"region": {
+				Description:  "The region of the container service, which defaults to the region of the provider",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateContainerRegion,
+			},
+			"cidr": {
+				Description: "The CIDR of the subnet",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"vlan_id": {
+				Description: "The ID of the VLAN of the subnet",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"subnet_type": {
+				Description: "The type of the subnet",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIBMContainerSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := containerAPI(d, meta)
+	if err != nil {
+		return err
+	}
+	clusterNameID := d.Get("cluster_name_id").(string)
+	subnetID := d.Get("subnet_id").(string)
+	targetEnv := getClusterTargetHeader(d)
+
+	err = csClient.Subnets().AddSubnet(clusterNameID, subnetID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error adding subnet %s to cluster %s: %s", subnetID, clusterNameID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", clusterNameID, subnetID))
+
+	return resourceIBMContainerSubnetRead(d, meta)
+}
+
+func resourceIBMContainerSubnetRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := containerAPI(d, meta)
+	if err != nil {
+		return err
+	}
+	clusterNameID, subnetID, err := parseContainerSubnetID(d.Id())
+	if err != nil {
+		return err
+	}
+	targetEnv := getClusterTargetHeader(d)
+
+	workers, err := csClient.Workers().List(clusterNameID, targetEnv)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] Cluster %s of subnet %s was not found", clusterNameID, subnetID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving workers of cluster %s: %s", clusterNameID, err)
+	}
+
+	subnets, err := csClient.Subnets().List(targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error retrieving subnets: %s", err)
+	}
+
+	subnet, ok := findClusterSubnet(subnets, subnetID, workers)
+	if !ok {
+		log.Printf("[WARN] Subnet %s was not found on the VLANs of cluster %s", subnetID, clusterNameID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_name_id", clusterNameID)
+	d.Set("subnet_id", subnetID)
+	d.Set("cidr", fmt.Sprintf("%s/%s", subnet.Properties.NetworkIdentifier, subnet.Properties.CIDR))
+	d.Set("vlan_id", subnet.VlanID)
+	d.Set("subnet_type", subnet.Type)
+
+	return nil
+}
+
+// findClusterSubnet returns the subnet with the ID when it is on a VLAN of the workers of the
+// cluster. The subnets API lists the subnets of the account, and does not tell to which cluster
+// a subnet was added.
+func findClusterSubnet(subnets []v1.Subnet, subnetID string, workers []v1.Worker) (v1.Subnet, bool) {
+	vlans := make(map[string]bool, 2*len(workers))
+	for _, worker := range workers {
+		vlans[worker.PrivateVlan] = true
+		vlans[worker.PublicVlan] = true
+	}
+	for _, subnet := range subnets {
+		if subnet.ID == subnetID && (len(workers) == 0 || vlans[subnet.VlanID]) {
+			return subnet, true
+		}
+	}
+	return v1.Subnet{}, false
+}
+
+// resourceIBMContainerSubnetDelete only removes the subnet from the state, since the container
+// service has no API to remove a subnet from a cluster
+func resourceIBMContainerSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Subnet %s stays attached to cluster %s, the container service cannot remove subnets from a cluster",
+		d.Get("subnet_id").(string), d.Get("cluster_name_id").(string))
+	d.SetId("")
+	return nil
+}
+
+func parseContainerSubnetID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Not a valid container subnet ID, must be <cluster_name_id>/<subnet_id>: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,105 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/IBM-Bluemix/bluemix-go/api/container/containerv1"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMContainerSubnet_basic(t *testing.T) {
+	clusterName := fmt.Sprintf("terraform_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerSubnet_basic(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_subnet.subnet", "subnet_id", containerSubnetID),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_subnet.subnet", "vlan_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseContainerSubnetID(t *testing.T) {
+	cluster, subnet, err := parseContainerSubnetID("mycluster/1234")
+	if err != nil || cluster != "mycluster" || subnet != "1234" {
+		t.Errorf("Expected mycluster and 1234, got %s and %s: %v", cluster, subnet, err)
+	}
+	for _, id := range []string{"mycluster", "mycluster/", "/1234", "a/b/c"} {
+		if _, _, err := parseContainerSubnetID(id); err == nil {
+			t.Errorf("Expected an error for ID %s", id)
+		}
+	}
+}
+
+func TestFindClusterSubnet(t *testing.T) {
+	subnets := []v1.Subnet{
+		{ID: "1234", VlanID: "100"},
+		{ID: "5678", VlanID: "300"},
+	}
+	workers := []v1.Worker{{PrivateVlan: "100", PublicVlan: "200"}}
+
+	if subnet, ok := findClusterSubnet(subnets, "1234", workers); !ok || subnet.VlanID != "100" {
+		t.Errorf("Expected subnet 1234 to be found on VLAN 100, got %v", subnet)
+	}
+	if _, ok := findClusterSubnet(subnets, "5678", workers); ok {
+		t.Errorf("Expected subnet 5678 not to be found, it is not on a VLAN of the cluster")
+	}
+	if _, ok := findClusterSubnet(subnets, "9999", workers); ok {
+		t.Errorf("Expected subnet 9999 not to be found")
+	}
+}
+
+func testAccCheckIBMContainerSubnet_basic(clusterName string) string {
+	return fmt.Sprintf(`
+
+data "ibm_org" "org" {
+    org = "%s"
+}
+
+data "ibm_space" "space" {
+  org    = "%s"
+  space  = "%s"
+}
+
+data "ibm_account" "acc" {
+   org_guid = "${data.ibm_org.org.id}"
+}
+
+resource "ibm_container_cluster" "testacc_cluster" {
+  name       = "%s"
+  datacenter = "%s"
+
+  org_guid = "${data.ibm_org.org.id}"
+	space_guid = "${data.ibm_space.space.id}"
+	account_guid = "${data.ibm_account.acc.id}"
+
+  workers = [{
+    name = "worker1"
+
+    action = "add"
+  }]
+
+  machine_type    = "%s"
+  isolation       = "public"
+  public_vlan_id  = "%s"
+  private_vlan_id = "%s"
+}
+
+resource "ibm_container_subnet" "subnet" {
+  cluster_name_id = "${ibm_container_cluster.testacc_cluster.id}"
+  subnet_id       = "%s"
+  org_guid        = "${data.ibm_org.org.id}"
+  space_guid      = "${data.ibm_space.space.id}"
+  account_guid    = "${data.ibm_account.acc.id}"
+}
+	`, cfOrganization, cfOrganization, cfSpace, clusterName, datacenter, machineType, publicVlanID, privateVlanID, containerSubnetID)
+}
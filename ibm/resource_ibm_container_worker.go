@@ -0,0 +1,318 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	v1 "github.com/IBM-Bluemix/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Bluemix/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	workerActionReboot  = "reboot"
+	workerActionReload  = "reload"
+	workerActionReplace = "replace"
+	workerActionAdd     = "add"
+)
+
+// claimedWorkers records the workers added by the replacements, so that concurrent replacements
+// in a cluster each track a different new worker
+var claimedWorkers = struct {
+	sync.Mutex
+	ids map[string]bool
+}{ids: make(map[string]bool)}
+
+func resourceIBMContainerWorker() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMContainerWorkerCreate,
+		Read:   resourceIBMContainerWorkerRead,
+		Update: resourceIBMContainerWorkerUpdate,
+		Delete: resourceIBMContainerWorkerDelete,
+		Exists: resourceIBMContainerWorkerExists,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name_id": {
+				Description: "Name or ID of the cluster of the worker",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"worker_id": {
+				Description: "ID of the worker on which to run the action",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"action": {
+				Description: "The action to run on the worker: reboot, reload or replace",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     workerActionReload,
+				ValidateFunc: validateAllowedStringValue([]string{
+					workerActionReboot, workerActionReload, workerActionReplace,
+				}),
+			},
+			"action_trigger": {
+				Description: "Any value; the action runs again on the worker whenever it or the action changes",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"wait_time_minutes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  90,
+			},
+			"replaced_worker_ids": {
+				Description: "IDs of the workers replaced by the resource, oldest first",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"current_worker_id": {
+				Description: "ID of the worker after the action, which differs from worker_id once the worker is replaced",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"state": {
+				Description: "State of the worker",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Status of the worker",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"org_guid": {
+				Description: "The bluemix organization guid this cluster belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"space_guid": {
+				Description: "The bluemix space guid this cluster belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"account_guid": {
+				Description: "The bluemix account guid this cluster belongs to",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"region": {
+				Description:  "The region of the container service, which defaults to the region of the provider",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateContainerRegion,
+			},
+		},
+	}
+}
+
+func resourceIBMContainerWorkerCreate(d *schema.ResourceData, meta interface{}) error {
+	workerID, err := runWorkerAction(d, meta, d.Get("worker_id").(string))
+	if err != nil {
+		return err
+	}
+	d.SetId(workerID)
+
+	return resourceIBMContainerWorkerRead(d, meta)
+}
+
+func resourceIBMContainerWorkerRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := containerAPI(d, meta)
+	if err != nil {
+		return err
+	}
+	targetEnv := getClusterTargetHeader(d)
+
+	worker, err := csClient.Workers().Get(d.Id(), targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error retrieving worker %s: %s", d.Id(), err)
+	}
+
+	d.Set("current_worker_id", worker.ID)
+	d.Set("state", worker.State)
+	d.Set("status", worker.Status)
+	d.Set("private_ip", worker.PrivateIP)
+	d.Set("public_ip", worker.PublicIP)
+
+	return nil
+}
+
+func resourceIBMContainerWorkerUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("action_trigger") || d.HasChange("action") {
+		workerID, err := runWorkerAction(d, meta, d.Id())
+		if err != nil {
+			return err
+		}
+		d.SetId(workerID)
+	}
+
+	return resourceIBMContainerWorkerRead(d, meta)
+}
+
+// resourceIBMContainerWorkerDelete only removes the resource from the state. The worker
+// stays in the cluster, and is removed by scaling down the cluster.
+func resourceIBMContainerWorkerDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMContainerWorkerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	csClient, err := containerAPI(d, meta)
+	if err != nil {
+		return false, err
+	}
+	targetEnv := getClusterTargetHeader(d)
+
+	worker, err := csClient.Workers().Get(d.Id(), targetEnv)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
+			if apiErr.StatusCode() == 404 {
+				return false, nil
+			}
+		}
+		return false, fmt.Errorf("Error communicating with the API: %s", err)
+	}
+	return worker.ID == d.Id() && worker.State != workerDeleteState, nil
+}
+
+// runWorkerAction runs the action on the worker and waits for the worker to be ready again. It
+// returns the ID of the worker, which is the ID of the new worker when the worker is replaced.
+func runWorkerAction(d *schema.ResourceData, meta interface{}, workerID string) (string, error) {
+	csClient, err := containerAPI(d, meta)
+	if err != nil {
+		return "", err
+	}
+	wrkAPI := csClient.Workers()
+	targetEnv := getClusterTargetHeader(d)
+	clusterNameID := d.Get("cluster_name_id").(string)
+	action := d.Get("action").(string)
+	timeout := time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute
+
+	if action == workerActionReplace {
+		newWorkerID, err := replaceWorker(wrkAPI, clusterNameID, workerID, targetEnv, timeout)
+		if err != nil {
+			return "", err
+		}
+		d.Set("replaced_worker_ids", append(d.Get("replaced_worker_ids").([]interface{}), workerID))
+		workerID = newWorkerID
+	} else {
+		log.Printf("[INFO] Running %s on worker %s of cluster %s", action, workerID, clusterNameID)
+		err = wrkAPI.Update(clusterNameID, workerID, v1.WorkerParam{Action: action}, targetEnv)
+		if err != nil {
+			return "", fmt.Errorf("Error running %s on worker %s: %s", action, workerID, err)
+		}
+	}
+
+	_, err = waitForWorkerReady(wrkAPI, workerID, targetEnv, timeout)
+	if err != nil {
+		return "", fmt.Errorf("Error waiting for worker %s to be ready: %s", workerID, err)
+	}
+	return workerID, nil
+}
+
+// replaceWorker deletes the worker and adds a new worker to the cluster, since the API has no
+// replace action. It returns the ID of the new worker. The API does not return the ID of the
+// added worker, so the new worker is the first worker which was not in the cluster before and
+// was not claimed by another replacement.
+func replaceWorker(wrkAPI v1.Workers, clusterNameID, workerID string, target v1.ClusterTargetHeader, timeout time.Duration) (string, error) {
+	workers, err := wrkAPI.List(clusterNameID, target)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+	}
+	knownIDs := make(map[string]bool, len(workers))
+	for _, w := range workers {
+		knownIDs[w.ID] = true
+	}
+
+	log.Printf("[INFO] Replacing worker %s of cluster %s", workerID, clusterNameID)
+	err = wrkAPI.Delete(clusterNameID, workerID, target)
+	if err != nil {
+		return "", fmt.Errorf("Error deleting worker %s: %s", workerID, err)
+	}
+	err = wrkAPI.Add(clusterNameID, v1.WorkerParam{Action: workerActionAdd, Count: 1}, target)
+	if err != nil {
+		return "", fmt.Errorf("Error adding a worker to replace worker %s: %s", workerID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry"},
+		Target:  []string{"added"},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := wrkAPI.List(clusterNameID, target)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error retrieving workers for cluster: %s", err)
+			}
+			if newID, ok := claimNewWorker(workers, knownIDs); ok {
+				return newID, "added", nil
+			}
+			return nil, "retry", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	newID, err := stateConf.WaitForState()
+	if err != nil {
+		return "", fmt.Errorf("Error waiting for the worker replacing worker %s: %s", workerID, err)
+	}
+	return newID.(string), nil
+}
+
+// claimNewWorker claims the first worker which is not known and not claimed yet
+func claimNewWorker(workers []v1.Worker, knownIDs map[string]bool) (string, bool) {
+	claimedWorkers.Lock()
+	defer claimedWorkers.Unlock()
+
+	for _, w := range workers {
+		if !knownIDs[w.ID] && !claimedWorkers.ids[w.ID] {
+			claimedWorkers.ids[w.ID] = true
+			return w.ID, true
+		}
+	}
+	return "", false
+}
+
+// waitForWorkerReady waits for a worker to be normal and Ready. The wait is delayed so that the
+// worker has left the Ready status when the action was just requested.
+func waitForWorkerReady(wrkAPI v1.Workers, workerID string, target v1.ClusterTargetHeader, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for worker (%s) to be ready.", workerID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", workerProvisioning},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := wrkAPI.Get(workerID, target)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error retrieving worker: %s", err)
+			}
+			if worker.State != workerNormal || worker.Status != workerReadyState {
+				return worker, workerProvisioning, nil
+			}
+			return worker, workerNormal, nil
+		},
+		Timeout:    timeout,
+		Delay:      60 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
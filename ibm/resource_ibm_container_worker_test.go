@@ -0,0 +1,128 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/IBM-Bluemix/bluemix-go/api/container/containerv1"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestClaimNewWorker(t *testing.T) {
+	knownIDs := map[string]bool{"claim-w1": true, "claim-w2": true}
+	workers := []v1.Worker{{ID: "claim-w1"}, {ID: "claim-w3"}, {ID: "claim-w4"}}
+
+	// Concurrent replacements must not track the same new worker
+	first, ok := claimNewWorker(workers, knownIDs)
+	if !ok || first != "claim-w3" {
+		t.Fatalf("Expected claim-w3 to be claimed, got %q", first)
+	}
+	second, ok := claimNewWorker(workers, knownIDs)
+	if !ok || second != "claim-w4" {
+		t.Fatalf("Expected claim-w4 to be claimed, got %q", second)
+	}
+	if id, ok := claimNewWorker(workers, knownIDs); ok {
+		t.Fatalf("Expected no worker to be claimed, got %q", id)
+	}
+}
+
+func TestAccIBMContainerWorker_reload(t *testing.T) {
+	clusterName := fmt.Sprintf("terraform_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerWorker(clusterName, "reload", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker.testacc_worker", "state", "normal"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker.testacc_worker", "status", "Ready"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerWorker(clusterName, "reload", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker.testacc_worker", "action_trigger", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker.testacc_worker", "state", "normal"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker.testacc_worker", "status", "Ready"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMContainerWorker_replace(t *testing.T) {
+	clusterName := fmt.Sprintf("terraform_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerWorker(clusterName, "replace", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker.testacc_worker", "state", "normal"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker.testacc_worker", "status", "Ready"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_worker.testacc_worker", "current_worker_id"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker.testacc_worker", "replaced_worker_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerWorker(clusterName, action, trigger string) string {
+	return fmt.Sprintf(`
+data "ibm_org" "org" {
+    org = "%s"
+}
+
+data "ibm_space" "space" {
+  org    = "%s"
+  space  = "%s"
+}
+
+data "ibm_account" "acc" {
+   org_guid = "${data.ibm_org.org.id}"
+}
+
+resource "ibm_container_cluster" "testacc_cluster" {
+    name = "%s"
+    datacenter = "%s"
+    workers = [{
+    name = "worker1"
+    action = "add"
+  },]
+	machine_type = "%s"
+	isolation = "public"
+	public_vlan_id = "%s"
+	private_vlan_id = "%s"
+
+    org_guid = "${data.ibm_org.org.id}"
+	space_guid = "${data.ibm_space.space.id}"
+	account_guid = "${data.ibm_account.acc.id}"
+}
+
+resource "ibm_container_worker" "testacc_worker" {
+    cluster_name_id = "${ibm_container_cluster.testacc_cluster.id}"
+    worker_id = "${ibm_container_cluster.testacc_cluster.workers.0.id}"
+    action = "%s"
+    action_trigger = "%s"
+
+    org_guid = "${data.ibm_org.org.id}"
+	space_guid = "${data.ibm_space.space.id}"
+	account_guid = "${data.ibm_account.acc.id}"
+}
+`, cfOrganization, cfOrganization, cfSpace, clusterName, datacenter, machineType, publicVlanID, privateVlanID, action, trigger)
+}
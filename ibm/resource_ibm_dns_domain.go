@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -12,6 +13,12 @@ import (
 	"github.com/softlayer/softlayer-go/sl"
 )
 
+// The types of the records which can be managed in the records of a domain
+var domainRecordsTypes = []string{"a", "aaaa", "cname", "ptr", "spf", "txt"}
+
+// The maximum number of records created or deleted in a single API call
+const domainRecordsBatchSize = 100
+
 func resourceIBMDNSDomain() *schema.Resource {
 	return &schema.Resource{
 		Exists:   resourceIBMDNSDomainExists,
@@ -42,6 +49,35 @@ func resourceIBMDNSDomain() *schema.Resource {
 				Optional: true,
 			},
 
+			"records": {
+				Description: "The resource records managed with the domain",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLowercase,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue(domainRecordsTypes),
+						},
+						"data": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  86400,
+						},
+					},
+				},
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -74,6 +110,9 @@ func resourceIBMDNSDomainCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	// The managed records are created with the domain
+	opts.ResourceRecords = append(opts.ResourceRecords, expandDomainRecords(d.Get("records").(*schema.Set).List(), 0)...)
+
 	// create Dns_Domain object
 	response, err := service.CreateObject(&opts)
 	if err != nil {
@@ -116,6 +155,10 @@ func resourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Only the managed records are read, the other records of the domain are left to the
+	// ibm_dns_record resources and to SoftLayer
+	d.Set("records", flattenDomainRecords(dns_domain.ResourceRecords, d.Get("records").(*schema.Set).List()))
+
 	return nil
 }
 
@@ -124,7 +167,14 @@ func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error
 	sess := meta.(ClientSession).SoftLayerSession()
 	domainId, _ := strconv.Atoi(d.Id())
 
-	if !d.HasChange("target") { // target is the only editable field
+	if d.HasChange("records") {
+		err := updateDomainRecords(d, meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !d.HasChange("target") {
 		return nil
 	}
 
@@ -177,7 +227,10 @@ func resourceIBMDNSDomainDelete(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Deleting Dns Domain: %d", dnsId)
 	result, err := service.Id(dnsId).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Dns Domain: %s", err)
+		if !isSoftLayerNotFound(err) {
+			return fmt.Errorf("Error deleting Dns Domain: %s", err)
+		}
+		result = true
 	}
 
 	if !result {
@@ -188,6 +241,120 @@ func resourceIBMDNSDomainDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// updateDomainRecords deletes the records removed from the records of the domain, then creates the
+// records added to them, in batches
+func updateDomainRecords(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	service := services.GetDnsDomainResourceRecordService(sess)
+	domainId, _ := strconv.Atoi(d.Id())
+
+	o, n := d.GetChange("records")
+	removed := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+	added := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+	if len(removed) > 0 {
+		domain, err := services.GetDnsDomainService(sess).Id(domainId).Mask("id,resourceRecords").GetObject()
+		if err != nil {
+			return fmt.Errorf("Error retrieving the records of Dns Domain %d: %s", domainId, err)
+		}
+		records := matchingDomainRecords(domain.ResourceRecords, removed)
+		toDelete := make([]datatypes.Dns_Domain_ResourceRecord, 0, len(records))
+		for _, rr := range records {
+			toDelete = append(toDelete, datatypes.Dns_Domain_ResourceRecord{Id: rr.Id})
+		}
+		for _, batch := range domainRecordBatches(toDelete) {
+			log.Printf("[INFO] Deleting %d records of Dns Domain %d", len(batch), domainId)
+			_, err = service.DeleteObjects(batch)
+			if err != nil && !isSoftLayerNotFound(err) {
+				return fmt.Errorf("Error deleting the records of Dns Domain %d: %s", domainId, err)
+			}
+		}
+	}
+
+	for _, batch := range domainRecordBatches(expandDomainRecords(added, domainId)) {
+		log.Printf("[INFO] Creating %d records of Dns Domain %d", len(batch), domainId)
+		_, err := service.CreateObjects(batch)
+		if err != nil {
+			return fmt.Errorf("Error creating the records of Dns Domain %d: %s", domainId, err)
+		}
+	}
+
+	return nil
+}
+
+// expandDomainRecords returns the resource records of the domain from the configured records. The
+// ID of the domain is not set when it is zero, for the records created with the domain.
+func expandDomainRecords(records []interface{}, domainId int) []datatypes.Dns_Domain_ResourceRecord {
+	result := make([]datatypes.Dns_Domain_ResourceRecord, 0, len(records))
+	for _, r := range records {
+		record := r.(map[string]interface{})
+		rr := datatypes.Dns_Domain_ResourceRecord{
+			Host: sl.String(record["host"].(string)),
+			Type: sl.String(record["type"].(string)),
+			Data: sl.String(record["data"].(string)),
+			Ttl:  sl.Int(record["ttl"].(int)),
+		}
+		if domainId != 0 {
+			rr.DomainId = sl.Int(domainId)
+		}
+		result = append(result, rr)
+	}
+	return result
+}
+
+// flattenDomainRecords returns the resource records of the domain which match one of the records.
+// The hosts and types are lowercased like the configured records, they are matched ignoring the case.
+func flattenDomainRecords(domainRecords []datatypes.Dns_Domain_ResourceRecord, records []interface{}) []map[string]interface{} {
+	matching := matchingDomainRecords(domainRecords, records)
+	result := make([]map[string]interface{}, 0, len(matching))
+	for _, rr := range matching {
+		result = append(result, map[string]interface{}{
+			"host": strings.ToLower(sl.Get(rr.Host, "").(string)),
+			"type": strings.ToLower(sl.Get(rr.Type, "").(string)),
+			"data": sl.Get(rr.Data, ""),
+			"ttl":  sl.Get(rr.Ttl, 0),
+		})
+	}
+	return result
+}
+
+// matchingDomainRecords returns the resource records of the domain which match one of the records
+// by host, type and data. A record matches every resource record of the domain with the same host,
+// type and data, so that duplicates are not hidden.
+func matchingDomainRecords(domainRecords []datatypes.Dns_Domain_ResourceRecord, records []interface{}) []datatypes.Dns_Domain_ResourceRecord {
+	keys := make(map[string]bool, len(records))
+	for _, r := range records {
+		record := r.(map[string]interface{})
+		keys[domainRecordKey(record["host"].(string), record["type"].(string), record["data"].(string))] = true
+	}
+
+	result := make([]datatypes.Dns_Domain_ResourceRecord, 0, len(records))
+	for _, rr := range domainRecords {
+		key := domainRecordKey(sl.Get(rr.Host, "").(string), sl.Get(rr.Type, "").(string), sl.Get(rr.Data, "").(string))
+		if keys[key] {
+			result = append(result, rr)
+		}
+	}
+	return result
+}
+
+func domainRecordKey(host, recordType, data string) string {
+	return strings.ToLower(host) + " " + strings.ToLower(recordType) + " " + data
+}
+
+// domainRecordBatches splits the records in batches of at most domainRecordsBatchSize records
+func domainRecordBatches(records []datatypes.Dns_Domain_ResourceRecord) [][]datatypes.Dns_Domain_ResourceRecord {
+	batches := make([][]datatypes.Dns_Domain_ResourceRecord, 0, (len(records)+domainRecordsBatchSize-1)/domainRecordsBatchSize)
+	for len(records) > domainRecordsBatchSize {
+		batches = append(batches, records[:domainRecordsBatchSize])
+		records = records[domainRecordsBatchSize:]
+	}
+	if len(records) > 0 {
+		batches = append(batches, records)
+	}
+	return batches
+}
+
 func resourceIBMDNSDomainExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess)
@@ -0,0 +1,199 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func resourceIBMDNSDomainRegistrationNameservers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMDNSDomainRegistrationNameserversCreate,
+		Read:   resourceIBMDNSDomainRegistrationNameserversRead,
+		Update: resourceIBMDNSDomainRegistrationNameserversUpdate,
+		Delete: resourceIBMDNSDomainRegistrationNameserversDelete,
+		Exists: resourceIBMDNSDomainRegistrationNameserversExists,
+
+		Schema: map[string]*schema.Schema{
+			"dns_registration_id": {
+				Description: "The ID of the domain registration",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name_servers": {
+				Description: "The name servers of the registered domain",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    2,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         hashNameServer,
+			},
+			"original_name_servers": {
+				Description: "The name servers of the registered domain before they were managed by Terraform, restored on destroy",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceIBMDNSDomainRegistrationNameserversCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	registrationID := d.Get("dns_registration_id").(int)
+
+	original, err := getDomainRegistrationNameServers(sess, registrationID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the name servers of domain registration %d: %s", registrationID, err)
+	}
+
+	err = setDomainRegistrationNameServers(sess, registrationID, original,
+		expandStringList(d.Get("name_servers").(*schema.Set).List()))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(registrationID))
+	d.Set("original_name_servers", original)
+
+	return resourceIBMDNSDomainRegistrationNameserversRead(d, meta)
+}
+
+func resourceIBMDNSDomainRegistrationNameserversRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	registrationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid domain registration ID, must be an integer: %s", err)
+	}
+
+	nameServers, err := getDomainRegistrationNameServers(sess, registrationID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the name servers of domain registration %d: %s", registrationID, err)
+	}
+
+	// The name servers are case insensitive, so the configured case is kept
+	configured := expandStringList(d.Get("name_servers").(*schema.Set).List())
+	for i, nameServer := range nameServers {
+		for _, c := range configured {
+			if strings.EqualFold(nameServer, c) {
+				nameServers[i] = c
+			}
+		}
+	}
+
+	d.Set("dns_registration_id", registrationID)
+	d.Set("name_servers", newStringSet(hashNameServer, nameServers))
+
+	return nil
+}
+
+func resourceIBMDNSDomainRegistrationNameserversUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	registrationID := d.Get("dns_registration_id").(int)
+
+	if d.HasChange("name_servers") {
+		o, n := d.GetChange("name_servers")
+		err := setDomainRegistrationNameServers(sess, registrationID,
+			expandStringList(o.(*schema.Set).List()), expandStringList(n.(*schema.Set).List()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMDNSDomainRegistrationNameserversRead(d, meta)
+}
+
+// resourceIBMDNSDomainRegistrationNameserversDelete restores the name servers which the domain
+// had before the resource was created
+func resourceIBMDNSDomainRegistrationNameserversDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	registrationID := d.Get("dns_registration_id").(int)
+
+	original := expandStringList(d.Get("original_name_servers").([]interface{}))
+	if len(original) == 0 {
+		return nil
+	}
+
+	current, err := getDomainRegistrationNameServers(sess, registrationID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the name servers of domain registration %d: %s", registrationID, err)
+	}
+
+	return setDomainRegistrationNameServers(sess, registrationID, current, original)
+}
+
+func resourceIBMDNSDomainRegistrationNameserversExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	sess := meta.(ClientSession).SoftLayerSession()
+	registrationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return false, fmt.Errorf("Not a valid domain registration ID, must be an integer: %s", err)
+	}
+
+	_, err = services.GetDnsDomainRegistrationService(sess).Id(registrationID).Mask("id").GetObject()
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error communicating with the API: %s", err)
+	}
+	return true, nil
+}
+
+// setDomainRegistrationNameServers replaces the name servers of the domain. The new name servers
+// are added before the old ones are removed, since the registry requires a domain to keep name
+// servers.
+func setDomainRegistrationNameServers(sess *session.Session, registrationID int, oldNameServers, newNameServers []string) error {
+	service := services.GetDnsDomainRegistrationService(sess).Id(registrationID)
+
+	added := missingNameServers(newNameServers, oldNameServers)
+	removed := missingNameServers(oldNameServers, newNameServers)
+
+	if len(added) > 0 {
+		log.Printf("[INFO] Adding name servers %v to domain registration %d", added, registrationID)
+		_, err := service.AddNameserversToDomain(added)
+		if err != nil {
+			return fmt.Errorf("Error adding name servers to domain registration %d: %s", registrationID, err)
+		}
+	}
+
+	if len(removed) > 0 {
+		log.Printf("[INFO] Removing name servers %v from domain registration %d", removed, registrationID)
+		_, err := service.RemoveNameserversFromDomain(removed)
+		if err != nil {
+			return fmt.Errorf("Error removing name servers from domain registration %d: %s", registrationID, err)
+		}
+	}
+
+	return nil
+}
+
+// missingNameServers returns the name servers which are not in the other name servers, ignoring
+// the case
+func missingNameServers(nameServers, others []string) []string {
+	missing := make([]string, 0, len(nameServers))
+	for _, nameServer := range nameServers {
+		found := false
+		for _, other := range others {
+			if strings.EqualFold(nameServer, other) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, nameServer)
+		}
+	}
+	return missing
+}
+
+func hashNameServer(v interface{}) int {
+	return hashcode.String(strings.ToLower(v.(string)))
+}
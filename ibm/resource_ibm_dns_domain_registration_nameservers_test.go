@@ -0,0 +1,58 @@
+package ibm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestMissingNameServers(t *testing.T) {
+	current := []string{"NS1.SOFTLAYER.COM", "ns2.softlayer.com"}
+	configured := []string{"ns1.softlayer.com", "ns3.softlayer.com"}
+
+	if added := missingNameServers(configured, current); !reflect.DeepEqual(added, []string{"ns3.softlayer.com"}) {
+		t.Errorf("Expected ns3.softlayer.com to be added, got %v", added)
+	}
+	if removed := missingNameServers(current, configured); !reflect.DeepEqual(removed, []string{"ns2.softlayer.com"}) {
+		t.Errorf("Expected ns2.softlayer.com to be removed, got %v", removed)
+	}
+	if hashNameServer("NS1.SoftLayer.com") != hashNameServer("ns1.softlayer.com") {
+		t.Errorf("Expected the name servers to be hashed regardless of the case")
+	}
+}
+
+func TestAccIBMDNSDomainRegistrationNameservers_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMDNSDomainRegistrationNameserversConfig(dnsRegistrationName, "ns1.softlayer.com", "ns2.softlayer.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_dns_domain_registration_nameservers.nameservers", "name_servers.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_dns_domain_registration_nameservers.nameservers", "original_name_servers.0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMDNSDomainRegistrationNameserversConfig(dnsRegistrationName, "ns1.softlayer.com", "ns3.softlayer.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_dns_domain_registration_nameservers.nameservers", "name_servers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDNSDomainRegistrationNameserversConfig(name, ns1, ns2 string) string {
+	return fmt.Sprintf(`
+data "ibm_dns_domain_registration" "registration" {
+    name = "%s"
+}
+
+resource "ibm_dns_domain_registration_nameservers" "nameservers" {
+    dns_registration_id = "${data.ibm_dns_domain_registration.registration.id}"
+    name_servers        = ["%s", "%s"]
+}`, name, ns1, ns2)
+}
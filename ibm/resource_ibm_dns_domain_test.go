@@ -105,6 +105,71 @@ func TestAccIBMDNSDomainWithTag(t *testing.T) {
 	})
 }
 
+func TestAccIBMDNSDomainWithRecords(t *testing.T) {
+	var dns_domain datatypes.Dns_Domain
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMDNSDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configWithRecords, domainName1, target1, target2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMDNSDomainExists("ibm_dns_domain.acceptance_test_dns_domain-1", &dns_domain),
+					resource.TestCheckResourceAttr(
+						"ibm_dns_domain.acceptance_test_dns_domain-1", "records.#", "3"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configWithUpdatedRecords, domainName1, target1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMDNSDomainExists("ibm_dns_domain.acceptance_test_dns_domain-1", &dns_domain),
+					resource.TestCheckResourceAttr(
+						"ibm_dns_domain.acceptance_test_dns_domain-1", "records.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestMatchingDomainRecords(t *testing.T) {
+	domainRecords := []datatypes.Dns_Domain_ResourceRecord{
+		{Id: sl.Int(1), Host: sl.String("@"), Type: sl.String("ns"), Data: sl.String("ns1.softlayer.com.")},
+		{Id: sl.Int(2), Host: sl.String("WWW"), Type: sl.String("A"), Data: sl.String("10.0.0.1"), Ttl: sl.Int(900)},
+		{Id: sl.Int(3), Host: sl.String("www"), Type: sl.String("a"), Data: sl.String("10.0.0.2"), Ttl: sl.Int(900)},
+		{Id: sl.Int(4), Host: sl.String("mail"), Type: sl.String("a"), Data: sl.String("10.0.0.3"), Ttl: sl.Int(900)},
+	}
+	records := []interface{}{
+		map[string]interface{}{"host": "WWW", "type": "a", "data": "10.0.0.1", "ttl": 86400},
+		map[string]interface{}{"host": "www", "type": "a", "data": "10.0.0.2", "ttl": 86400},
+	}
+
+	matching := matchingDomainRecords(domainRecords, records)
+	if len(matching) != 2 || *matching[0].Id != 2 || *matching[1].Id != 3 {
+		t.Fatalf("Expected the records 2 and 3, got %v", matching)
+	}
+
+	flattened := flattenDomainRecords(domainRecords, records)
+	if len(flattened) != 2 || flattened[0]["ttl"] != 900 || flattened[1]["data"] != "10.0.0.2" {
+		t.Errorf("Unexpected flattened records: %v", flattened)
+	}
+	if flattened[0]["host"] != "www" || flattened[0]["type"] != "a" {
+		t.Errorf("Expected the host and type to be lowercased, got %v", flattened[0])
+	}
+}
+
+func TestDomainRecordBatches(t *testing.T) {
+	records := make([]datatypes.Dns_Domain_ResourceRecord, 2*domainRecordsBatchSize+1)
+	batches := domainRecordBatches(records)
+	if len(batches) != 3 || len(batches[0]) != domainRecordsBatchSize || len(batches[2]) != 1 {
+		t.Errorf("Unexpected batches of %d records: %d batches", len(records), len(batches))
+	}
+	if batches := domainRecordBatches(nil); len(batches) != 0 {
+		t.Errorf("Expected no batches, got %d", len(batches))
+	}
+}
+
 func testAccCheckIBMDNSDomainDestroy(s *terraform.State) error {
 	service := services.GetDnsDomainService(testAccProvider.Meta().(ClientSession).SoftLayerSession())
 
@@ -232,6 +297,38 @@ resource "ibm_dns_domain" "acceptance_test_dns_domain-1" {
 }
 `
 
+var configWithRecords = `
+resource "ibm_dns_domain" "acceptance_test_dns_domain-1" {
+	name = "%[1]s"
+	records {
+		host = "www"
+		type = "a"
+		data = "%[2]s"
+	}
+	records {
+		host = "www"
+		type = "a"
+		data = "%[3]s"
+	}
+	records {
+		host = "info"
+		type = "txt"
+		data = "managed by terraform"
+		ttl = 900
+	}
+}
+`
+var configWithUpdatedRecords = `
+resource "ibm_dns_domain" "acceptance_test_dns_domain-1" {
+	name = "%s"
+	records {
+		host = "www"
+		type = "a"
+		data = "%s"
+	}
+}
+`
+
 var domainName1 = fmt.Sprintf("tfuatdomain%s.com", acctest.RandString(10))
 var domainName2 = fmt.Sprintf("tfuatdomain%s.com", acctest.RandString(10))
 var target1 = "172.16.0.100"
@@ -407,7 +407,7 @@ func resourceIBMDNSRecordDelete(d *schema.ResourceData, meta interface{}) error
 
 	_, err = service.Id(id).DeleteObject()
 
-	if err != nil {
+	if err != nil && !isSoftLayerNotFound(err) {
 		return fmt.Errorf("Error deleting DNS Resource Record: %s", err)
 	}
 
@@ -52,6 +52,38 @@ func resourceIBMFirewall() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"dry_run_quote": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"wait_time_minutes": {
+				Description:  "The number of minutes to wait for the firewall to be provisioned once ordered",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      45,
+				ValidateFunc: validateIntegerInRange(1, 1440),
+			},
+			"wait_interval_seconds": {
+				Description:  "The minimum number of seconds between two checks of the provisioning of the firewall",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validateIntegerInRange(1, 600),
+			},
+			"quote_hourly_cost": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"quote_monthly_cost": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"quote_setup_cost": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -103,16 +135,23 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 		VlanId: sl.Int(publicVlanId),
 	}
 
+	if isDryRunQuote(d, meta) {
+		log.Println("[INFO] Pricing dedicated hardware firewall order")
+		return quoteOrder(d, meta, &productOrderContainer)
+	}
+
 	log.Println("[INFO] Creating dedicated hardware firewall")
 
-	receipt, err := services.GetProductOrderService(sess).
-		PlaceOrder(&productOrderContainer, sl.Bool(false))
+	receipt, err := placeOrder(meta, productOrderContainer.PackageId, &productOrderContainer)
 	if err != nil {
 		return fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err)
 	}
-	vlan, err := findDedicatedFirewallByOrderId(sess, *receipt.OrderId)
+	setPendingOrderID(d, *receipt.OrderId)
+
+	vlan, err := findDedicatedFirewallByOrderId(sess, *receipt.OrderId, orderWaitTimeout(d), orderWaitInterval(d))
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err)
+		logPendingOrderWait("dedicated hardware firewall", *receipt.OrderId, err)
+		return nil
 	}
 
 	id := *vlan.NetworkVlanFirewall.Id
@@ -136,8 +175,23 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMFirewallRead(d *schema.ResourceData, meta interface{}) error {
+	if isQuoteID(d.Id()) {
+		// The firewall is only priced, it gets ordered once dry run is disabled
+		if !isDryRunQuote(d, meta) {
+			d.SetId("")
+		}
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingFirewall(d, sess)
+		if err != nil || !provisioned {
+			return err
+		}
+	}
+
 	fwID, _ := strconv.Atoi(d.Id())
 
 	fw, err := services.GetNetworkVlanFirewallService(sess).
@@ -152,20 +206,18 @@ func resourceIBMFirewallRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("public_vlan_id", *fw.NetworkVlan.Id)
 	d.Set("ha_enabled", *fw.NetworkVlan.HighAvailabilityFirewallFlag)
 
-	tagRefs := fw.TagReferences
-	tagRefsLen := len(tagRefs)
-	if tagRefsLen > 0 {
-		tags := make([]string, tagRefsLen, tagRefsLen)
-		for i, tagRef := range tagRefs {
-			tags[i] = *tagRef.Tag.Name
-		}
-		d.Set("tags", tags)
-	}
+	d.Set("tags", flattenTagReferences(fw.TagReferences))
 
 	return nil
 }
 
 func resourceIBMFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
+	if isQuoteID(d.Id()) {
+		return nil
+	}
+	if isPendingOrderID(d.Id()) {
+		return pendingOrderNotProvisionedError("dedicated hardware firewall", d.Id())
+	}
 
 	fwID, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -184,14 +236,31 @@ func resourceIBMFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMFirewallDelete(d *schema.ResourceData, meta interface{}) error {
+	if isQuoteID(d.Id()) {
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	fwService := services.GetNetworkVlanFirewallService(sess)
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingFirewall(d, sess)
+		if err != nil {
+			return err
+		}
+		if !provisioned {
+			return pendingOrderNotProvisionedError("dedicated hardware firewall", d.Id())
+		}
+	}
+
 	fwID, _ := strconv.Atoi(d.Id())
 
 	// Get billing item associated with the firewall
 	billingItem, err := fwService.Id(fwID).GetBillingItem()
-
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The firewall %d was already deleted", fwID)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("Error while looking up billing item associated with the firewall: %s", err)
 	}
@@ -213,6 +282,10 @@ func resourceIBMFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMFirewallExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	if isQuoteID(d.Id()) || isPendingOrderID(d.Id()) {
+		return true, nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	fwID, err := strconv.Atoi(d.Id())
@@ -234,19 +307,42 @@ func resourceIBMFirewallExists(d *schema.ResourceData, meta interface{}) (bool,
 	return true, nil
 }
 
-func findDedicatedFirewallByOrderId(sess *session.Session, orderId int) (datatypes.Network_Vlan, error) {
+func getDedicatedFirewallVlansByOrderId(sess *session.Session, orderId int) ([]datatypes.Network_Vlan, error) {
 	filterPath := "networkVlans.networkVlanFirewall.billingItem.orderItem.order.id"
 
+	return getAccountNetworkVlans(services.GetAccountService(sess).
+		Filter(filter.Build(
+			filter.Path(filterPath).
+				Eq(strconv.Itoa(orderId)))).
+		Mask(vlanMask))
+}
+
+// adoptPendingFirewall replaces the pending order id of the firewall by the id of the firewall once
+// it is provisioned, and tells whether it is
+func adoptPendingFirewall(d *schema.ResourceData, sess *session.Session) (bool, error) {
+	orderId, err := pendingOrderID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	vlans, err := getDedicatedFirewallVlansByOrderId(sess, orderId)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving dedicated hardware firewall of order %d: %s", orderId, err)
+	}
+	if len(vlans) == 0 || vlans[0].NetworkVlanFirewall == nil || vlans[0].NetworkVlanFirewall.Id == nil {
+		log.Printf("[INFO] The dedicated hardware firewall of order %d is not provisioned yet", orderId)
+		return false, nil
+	}
+	d.SetId(strconv.Itoa(*vlans[0].NetworkVlanFirewall.Id))
+	log.Printf("[INFO] Adopted dedicated hardware firewall %s of order %d", d.Id(), orderId)
+	return true, nil
+}
+
+func findDedicatedFirewallByOrderId(sess *session.Session, orderId int, timeout, interval time.Duration) (datatypes.Network_Vlan, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
 		Refresh: func() (interface{}, string, error) {
-			vlans, err := services.GetAccountService(sess).
-				Filter(filter.Build(
-					filter.Path(filterPath).
-						Eq(strconv.Itoa(orderId)))).
-				Mask(vlanMask).
-				GetNetworkVlans()
+			vlans, err := getDedicatedFirewallVlansByOrderId(sess, orderId)
 			if err != nil {
 				return datatypes.Network_Vlan{}, "", err
 			}
@@ -259,9 +355,9 @@ func findDedicatedFirewallByOrderId(sess *session.Session, orderId int) (datatyp
 				return nil, "", fmt.Errorf("Expected one dedicated firewall: %s", err)
 			}
 		},
-		Timeout:    45 * time.Minute,
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+		MinTimeout: interval,
 	}
 
 	pendingResult, err := stateConf.WaitForState()
@@ -37,8 +37,10 @@ func resourceIBMFirewallPolicy() *schema.Resource {
 			},
 
 			"rules": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"rules_file"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"action": {
@@ -86,10 +88,32 @@ func resourceIBMFirewallPolicy() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"order_value": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateIntegerInRange(1, 65535),
+						},
 					},
 				},
 			},
 
+			"rule_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"rules_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rules"},
+			},
+
+			"rules_file_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -100,8 +124,23 @@ func resourceIBMFirewallPolicy() *schema.Resource {
 	}
 }
 
-func prepareRules(d *schema.ResourceData) []datatypes.Network_Firewall_Update_Request_Rule {
+// getRuleList returns the rules configured for the firewall, either from the rules attribute
+// or from the file set in rules_file
+func getRuleList(d *schema.ResourceData) ([]interface{}, error) {
+	if rulesFile, ok := d.GetOk("rules_file"); ok {
+		return readFirewallRulesFile(rulesFile.(string))
+	}
 	ruleList := d.Get("rules").([]interface{})
+	if len(ruleList) == 0 {
+		return nil, fmt.Errorf("One of rules or rules_file must be set")
+	}
+	if err := validateFirewallRulesOrder(ruleList); err != nil {
+		return nil, err
+	}
+	return ruleList, nil
+}
+
+func prepareRules(ruleList []interface{}) []datatypes.Network_Firewall_Update_Request_Rule {
 	rules := make([]datatypes.Network_Firewall_Update_Request_Rule, 0)
 	for i, ruleItem := range ruleList {
 		ruleMap := ruleItem.(map[string]interface{})
@@ -155,7 +194,11 @@ func getFirewallContextAccessControlListId(fwId int, sess *session.Session) (int
 func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(ClientSession).SoftLayerSession()
 	fwId := d.Get("firewall_id").(int)
-	rules := prepareRules(d)
+	ruleList, err := getRuleList(d)
+	if err != nil {
+		return fmt.Errorf("Error during creation of dedicated hardware firewall rules: %s", err)
+	}
+	rules := prepareRules(ruleList)
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
@@ -197,8 +240,32 @@ func resourceIBMFirewallPolicyRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error retrieving firewall rules: %s", err)
 	}
 
-	rules := make([]map[string]interface{}, 0, len(fw.Rules))
-	for _, rule := range fw.Rules {
+	rules := flattenFirewallRules(fw.Rules)
+
+	// The rules are numbered contiguously on the device, keep the configured order values
+	keepFirewallRulesOrderValues(rules, d.Get("rules").([]interface{}))
+
+	// The hash of the device rules is compared with the hash of rules_file when the policy is
+	// planned, so that the file is applied again when the device rules no longer match it
+	if _, ok := d.GetOk("rules_file"); ok {
+		deviceRules := make([]interface{}, len(rules))
+		for i, rule := range rules {
+			deviceRules[i] = rule
+		}
+		d.Set("rules_file_hash", firewallRulesHash(deviceRules))
+	} else {
+		d.Set("rules_file_hash", "")
+	}
+
+	d.Set("firewall_id", fwRulesID)
+	d.Set("rules", rules)
+
+	return nil
+}
+
+func flattenFirewallRules(fwRules []datatypes.Network_Vlan_Firewall_Rule) []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0, len(fwRules))
+	for _, rule := range fwRules {
 		r := make(map[string]interface{})
 		r["action"] = *rule.Action
 		r["src_ip_address"] = *rule.SourceIpAddress
@@ -217,11 +284,7 @@ func resourceIBMFirewallPolicyRead(d *schema.ResourceData, meta interface{}) err
 		}
 		rules = append(rules, r)
 	}
-
-	d.Set("firewall_id", fwRulesID)
-	d.Set("rules", rules)
-
-	return nil
+	return rules
 }
 
 func appendAnyOpenRule(rules []datatypes.Network_Firewall_Update_Request_Rule, protocol string) []datatypes.Network_Firewall_Update_Request_Rule {
@@ -256,7 +319,16 @@ func resourceIBMFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err)
 	}
-	rules := prepareRules(d)
+	ruleList, err := getRuleList(d)
+	if err != nil {
+		return fmt.Errorf("Error during updating of dedicated hardware firewall rules: %s", err)
+	}
+	rules := prepareRules(ruleList)
+
+	oldRules, _ := d.GetChange("rules")
+	for _, change := range describeFirewallRuleChanges(oldRules.([]interface{}), ruleList) {
+		log.Printf("[INFO] Firewall %d: %s", fwId, change)
+	}
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
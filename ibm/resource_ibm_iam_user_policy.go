@@ -35,6 +35,10 @@ func resourceIBMIAMUserPolicy() *schema.Resource {
 		Delete:   resourceIBMIAMUserPolicyDelete,
 		Exists:   resourceIBMIAMUserPolicyExists,
 		Importer: &schema.ResourceImporter{},
+
+		SchemaVersion: 1,
+		MigrateState:  resourceIBMIAMUserPolicyMigrateState,
+
 		Schema: map[string]*schema.Schema{
 			"account_guid": {
 				Description: "The bluemix account guid",
@@ -49,7 +53,7 @@ func resourceIBMIAMUserPolicy() *schema.Resource {
 				ForceNew:    true,
 			},
 			"resources": {
-				Type:     schema.TypeSet,
+				Type:     schema.TypeList,
 				Required: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
@@ -126,7 +130,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	policyServices := d.Get("resources").(*schema.Set)
+	policyServices := d.Get("resources").([]interface{})
 	resources, err := expandResources(policyServices, iamClient, accountGUID)
 	if err != nil {
 		return err
@@ -193,7 +197,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 		if err != nil {
 			return err
 		}
-		policyServices := d.Get("resources").(*schema.Set)
+		policyServices := d.Get("resources").([]interface{})
 		resources, err := expandResources(policyServices, iamClient, accountGUID)
 		if err != nil {
 			return err
@@ -267,9 +271,9 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 	return policyID == accessPolicyResponse.ID, nil
 }
 
-func expandResources(policyServices *schema.Set, iamClient v1.IAMPAPAPI, accountGUID string) ([]v1.Resources, error) {
+func expandResources(policyServices []interface{}, iamClient v1.IAMPAPAPI, accountGUID string) ([]v1.Resources, error) {
 	var resources []v1.Resources
-	for _, policyService := range policyServices.List() {
+	for _, policyService := range policyServices {
 		rpm, _ := policyService.(map[string]interface{})
 		serviceInstancesList := expandStringList(rpm["service_instance"].([]interface{}))
 		serviceName, err := iamClient.IAMService().GetServiceName(rpm["service_name"].(string))
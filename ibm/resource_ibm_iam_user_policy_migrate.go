@@ -0,0 +1,43 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceIBMIAMUserPolicyMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found IBM IAM User Policy State v0; migrating to v1")
+		return migrateIAMUserPolicyStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// migrateIAMUserPolicyStateV0toV1 moves the single element of the resources set, keyed by its hash
+// in v0, to the first element of the resources list
+func migrateIAMUserPolicyStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() || is.Attributes == nil {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+	for k, v := range is.Attributes {
+		if !strings.HasPrefix(k, "resources.") || k == "resources.#" {
+			continue
+		}
+		parts := strings.SplitN(k, ".", 3)
+		if len(parts) != 3 || parts[1] == "0" {
+			continue
+		}
+		delete(is.Attributes, k)
+		is.Attributes["resources.0."+parts[2]] = v
+	}
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
@@ -0,0 +1,75 @@
+package ibm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestIBMIAMUserPolicyMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		StateVersion int
+		Attributes   map[string]string
+		Expected     map[string]string
+	}{
+		"v0_1": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"ibm_id":                                  "user@example.com",
+				"resources.#":                             "1",
+				"resources.2934789123.service_name":       "All Identity and Access enabled services",
+				"resources.2934789123.region":             "us-south",
+				"resources.2934789123.service_instance.#": "1",
+				"resources.2934789123.service_instance.0": "1refjnjb-vr4-vverr",
+				"roles.#":          "1",
+				"roles.1193843283": "viewer",
+			},
+			Expected: map[string]string{
+				"ibm_id":                         "user@example.com",
+				"resources.#":                    "1",
+				"resources.0.service_name":       "All Identity and Access enabled services",
+				"resources.0.region":             "us-south",
+				"resources.0.service_instance.#": "1",
+				"resources.0.service_instance.0": "1refjnjb-vr4-vverr",
+				"roles.#":                        "1",
+				"roles.1193843283":               "viewer",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "policy-id",
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceIBMIAMUserPolicyMigrateState(tc.StateVersion, is, nil)
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if !reflect.DeepEqual(is.Attributes, tc.Expected) {
+			t.Fatalf("bad: %s\n\n expected: %#v -> got: %#v", tn, tc.Expected, is.Attributes)
+		}
+	}
+}
+
+func TestIBMIAMUserPolicyMigrateState_empty(t *testing.T) {
+	var is *terraform.InstanceState
+
+	// should handle nil
+	is, err := resourceIBMIAMUserPolicyMigrateState(0, is, nil)
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+	if is != nil {
+		t.Fatalf("expected nil instancestate, got: %#v", is)
+	}
+
+	// should handle non-nil but empty
+	is = &terraform.InstanceState{}
+	_, err = resourceIBMIAMUserPolicyMigrateState(0, is, nil)
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+}
@@ -24,6 +24,8 @@ func TestAccIBMIAMUserPolicy_Basic(t *testing.T) {
 						"ibm_iam_user_policy.testacc_iam_policy", "roles.#", "1"),
 					resource.TestCheckResourceAttr(
 						"ibm_iam_user_policy.testacc_iam_policy", "resources.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_iam_user_policy.testacc_iam_policy", "resources.0.service_name"),
 				),
 			},
 			resource.TestStep{
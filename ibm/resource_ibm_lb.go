@@ -182,15 +182,17 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Println("[INFO] Creating load balancer")
 
-	receipt, err := services.GetProductOrderService(sess).
-		PlaceOrder(&productOrderContainer, sl.Bool(false))
+	receipt, err := placeOrder(meta, productOrderContainer.PackageId, &productOrderContainer)
 	if err != nil {
 		return fmt.Errorf("Error during creation of load balancer: %s", err)
 	}
 
+	setPendingOrderID(d, *receipt.OrderId)
+
 	loadBalancer, err := findLoadBalancerByOrderId(sess, *receipt.OrderId, dedicated)
 	if err != nil {
-		return fmt.Errorf("Error during creation of load balancer: %s", err)
+		logPendingOrderWait("load balancer", *receipt.OrderId, err)
+		return nil
 	}
 
 	d.SetId(fmt.Sprintf("%d", *loadBalancer.Id))
@@ -206,16 +208,40 @@ func resourceIBMLbCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
+	if isPendingOrderID(d.Id()) {
+		return pendingOrderNotProvisionedError("load balancer", d.Id())
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vipID, _ := strconv.Atoi(d.Id())
 
-	certID := d.Get("security_certificate_id").(int)
+	// The certificate is replaced on the existing VIP, so that rotating it doesn't recreate the
+	// load balancer
+	if d.HasChange("security_certificate_id") || d.IsNewResource() {
+		certID := d.Get("security_certificate_id").(int)
 
-	err := setLocalLBSecurityCert(sess, vipID, certID)
+		if certID != 0 {
+			if !d.Get("ssl_enabled").(bool) {
+				return fmt.Errorf(
+					"Update load balancer failed: load balancer %d was ordered without SSL offload, "+
+						"it must be recreated to use a security certificate", vipID)
+			}
 
-	if err != nil {
-		return fmt.Errorf("Update load balancer failed: %s", err)
+			// Check the new certificate before replacing the current one
+			_, err := services.GetSecurityCertificateService(sess).Id(certID).Mask("id").GetObject()
+			if err != nil {
+				return fmt.Errorf("Update load balancer failed: error retrieving security certificate %d: %s", certID, err)
+			}
+		}
+
+		if certID != 0 || !d.IsNewResource() {
+			log.Printf("[INFO] Setting security certificate %d of load balancer %d", certID, vipID)
+			err := setLocalLBSecurityCert(sess, vipID, certID)
+			if err != nil {
+				return fmt.Errorf("Update load balancer failed: %s", err)
+			}
+		}
 	}
 
 	return resourceIBMLbRead(d, meta)
@@ -224,6 +250,13 @@ func resourceIBMLbUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceIBMLbRead(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(ClientSession).SoftLayerSession()
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingLoadBalancer(d, sess)
+		if err != nil || !provisioned {
+			return err
+		}
+	}
+
 	vipID, _ := strconv.Atoi(d.Id())
 
 	vip, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualIpAddressService(sess).
@@ -253,6 +286,16 @@ func resourceIBMLbDelete(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(ClientSession).SoftLayerSession()
 	vipService := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualIpAddressService(sess)
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingLoadBalancer(d, sess)
+		if err != nil {
+			return err
+		}
+		if !provisioned {
+			return pendingOrderNotProvisionedError("load balancer", d.Id())
+		}
+	}
+
 	vipID, _ := strconv.Atoi(d.Id())
 
 	var billingItem datatypes.Billing_Item_Network_LoadBalancer
@@ -269,6 +312,10 @@ func resourceIBMLbDelete(d *schema.ResourceData, meta interface{}) error {
 			GetBillingItem()
 	}
 
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The load balancer %d was already deleted", vipID)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("Error while looking up billing item associated with the load balancer: %s", err)
 	}
@@ -289,6 +336,10 @@ func resourceIBMLbDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMLbExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	if isPendingOrderID(d.Id()) {
+		return true, nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vipID, _ := strconv.Atoi(d.Id())
@@ -322,23 +373,11 @@ func getConnectionLimit(connectionLimit int) int {
 }
 
 func findLoadBalancerByOrderId(sess *session.Session, orderId int, dedicated bool) (datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, error) {
-	var filterPath string
-	if dedicated {
-		filterPath = "adcLoadBalancers.dedicatedBillingItem.orderItem.order.id"
-	} else {
-		filterPath = "adcLoadBalancers.billingItem.orderItem.order.id"
-	}
-
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
 		Refresh: func() (interface{}, string, error) {
-			lbs, err := services.GetAccountService(sess).
-				Filter(filter.Build(
-					filter.Path(filterPath).
-						Eq(strconv.Itoa(orderId)))).
-				Mask(lbMask).
-				GetAdcLoadBalancers()
+			lbs, err := getLoadBalancersByOrderId(sess, orderId, dedicated)
 			if err != nil {
 				return datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{}, "", err
 			}
@@ -372,6 +411,39 @@ func findLoadBalancerByOrderId(sess *session.Session, orderId int, dedicated boo
 		fmt.Errorf("Cannot find Application Delivery Controller Load Balancer with order id '%d'", orderId)
 }
 
+func getLoadBalancersByOrderId(sess *session.Session, orderId int, dedicated bool) ([]datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, error) {
+	filterPath := "adcLoadBalancers.billingItem.orderItem.order.id"
+	if dedicated {
+		filterPath = "adcLoadBalancers.dedicatedBillingItem.orderItem.order.id"
+	}
+	return services.GetAccountService(sess).
+		Filter(filter.Build(
+			filter.Path(filterPath).
+				Eq(strconv.Itoa(orderId)))).
+		Mask(lbMask).
+		GetAdcLoadBalancers()
+}
+
+// adoptPendingLoadBalancer replaces the pending order id of the load balancer by the id of the
+// load balancer once it is provisioned, and tells whether it is
+func adoptPendingLoadBalancer(d *schema.ResourceData, sess *session.Session) (bool, error) {
+	orderId, err := pendingOrderID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	lbs, err := getLoadBalancersByOrderId(sess, orderId, d.Get("dedicated").(bool))
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving load balancer of order %d: %s", orderId, err)
+	}
+	if len(lbs) != 1 {
+		log.Printf("[INFO] The load balancer of order %d is not provisioned yet", orderId)
+		return false, nil
+	}
+	d.SetId(strconv.Itoa(*lbs[0].Id))
+	log.Printf("[INFO] Adopted load balancer %s of order %d", d.Id(), orderId)
+	return true, nil
+}
+
 func setLocalLBSecurityCert(sess *session.Session, vipID int, certID int) error {
 	var vip struct {
 		SecurityCertificateId *int `json:"securityCertificateId"`
@@ -395,7 +467,10 @@ func setLocalLBSecurityCert(sess *session.Session, vipID int, certID int) error
 	)
 
 	if !success && err == nil {
-		return fmt.Errorf("Unable to remove ssl security certificate from load balancer")
+		if certID == 0 {
+			return fmt.Errorf("Unable to remove ssl security certificate from load balancer %d", vipID)
+		}
+		return fmt.Errorf("Unable to set ssl security certificate %d on load balancer %d", certID, vipID)
 	}
 
 	return err
@@ -265,8 +265,8 @@ func resourceIBMLbServiceDelete(d *schema.ResourceData, meta interface{}) error
 					strings.Contains(apiErr.Message, "The resource '480' is already in use."):
 					// The LB is busy with another transaction. Retry
 					return false, "pending", nil
-				case apiErr.StatusCode == 404 || // 404 - service was deleted on the previous attempt
-					strings.Contains(apiErr.Message, "Unable to find object with id"): // xmlrpc returns 200 instead of 404
+				case isSoftLayerNotFound(err):
+					// The service was deleted on the previous attempt, or out-of-band
 					return true, "complete", nil
 				default:
 					// Any other error is unexpected. Abort
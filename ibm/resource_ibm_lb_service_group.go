@@ -211,8 +211,8 @@ func resourceIBMLbServiceGroupDelete(d *schema.ResourceData, meta interface{}) e
 					strings.Contains(apiErr.Message, "The resource '480' is already in use."):
 					// The LB is busy with another transaction. Retry
 					return false, "pending", nil
-				case apiErr.StatusCode == 404:
-					// 404 - service was deleted on the previous attempt
+				case isSoftLayerNotFound(err):
+					// The service group was deleted on the previous attempt, or out-of-band
 					return true, "complete", nil
 				default:
 					// Any other error is unexpected. Abort
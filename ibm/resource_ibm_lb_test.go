@@ -1,9 +1,12 @@
 package ibm
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccIBMLbShared_Basic(t *testing.T) {
@@ -99,6 +102,76 @@ func TestAccIBMLbSharedWithTag(t *testing.T) {
 	})
 }
 
+func TestAccIBMLbShared_SecurityCertificateRotation(t *testing.T) {
+	var lbID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMLbSharedConfigWithCert("test-cert"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMLbID("ibm_lb.testacc_foobar_lb", &lbID),
+					resource.TestCheckResourceAttr(
+						"ibm_lb.testacc_foobar_lb", "ssl_enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_lb.testacc_foobar_lb", "security_certificate_id",
+						"ibm_compute_ssl_certificate.test-cert", "id"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccCheckIBMLbSharedConfigWithCert("test-cert-rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMLbSameID("ibm_lb.testacc_foobar_lb", &lbID),
+					resource.TestCheckResourceAttrPair(
+						"ibm_lb.testacc_foobar_lb", "security_certificate_id",
+						"ibm_compute_ssl_certificate.test-cert-rotated", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMLbID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckIBMLbSameID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("Load balancer was recreated: ID changed from %s to %s", *id, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// testAccCheckIBMLbSharedConfigWithCert returns a shared load balancer using the certificate of
+// the given resource name. Both certificates are declared so that the first one outlives the
+// rotation.
+func testAccCheckIBMLbSharedConfigWithCert(certName string) string {
+	return testAccCheckIBMComputeSSLCertificateConfig_basic +
+		strings.Replace(testAccCheckIBMComputeSSLCertificateConfig_basic, `"test-cert"`, `"test-cert-rotated"`, 1) +
+		fmt.Sprintf(`
+resource "ibm_lb" "testacc_foobar_lb" {
+    connections = 250
+    datacenter    = "dal09"
+    ha_enabled  = false
+    security_certificate_id = "${ibm_compute_ssl_certificate.%s.id}"
+}`, certName)
+}
+
 const testAccCheckIBMLbSharedConfig_basic = `
 resource "ibm_lb" "testacc_foobar_lb" {
     connections = 250
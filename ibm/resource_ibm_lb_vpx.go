@@ -35,6 +35,9 @@ func resourceIBMLbVpx() *schema.Resource {
 		Exists:   resourceIBMLbVpxExists,
 		Importer: &schema.ResourceImporter{},
 
+		SchemaVersion: 1,
+		MigrateState:  resourceIBMLbVpxMigrateState,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -271,6 +274,35 @@ func findVPXPriceItems(version string, speed int, plan string, ipCount int, meta
 	}, nil
 }
 
+func getVPXsByOrderId(service services.Account, orderId int) ([]datatypes.Network_Application_Delivery_Controller, error) {
+	return service.
+		Filter(
+			filter.Build(
+				filter.Path("applicationDeliveryControllers.billingItem.orderItem.order.id").Eq(orderId),
+			),
+		).GetApplicationDeliveryControllers()
+}
+
+// adoptPendingVPX replaces the pending order id of the VPX by the id of the VPX once it is
+// provisioned, and tells whether it is
+func adoptPendingVPX(d *schema.ResourceData, meta interface{}) (bool, error) {
+	orderId, err := pendingOrderID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	vpxs, err := getVPXsByOrderId(services.GetAccountService(meta.(ClientSession).SoftLayerSession()), orderId)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving network application delivery controller of order %d: %s", orderId, err)
+	}
+	if len(vpxs) != 1 {
+		log.Printf("[INFO] The network application delivery controller of order %d is not provisioned yet", orderId)
+		return false, nil
+	}
+	d.SetId(strconv.Itoa(*vpxs[0].Id))
+	log.Printf("[INFO] Adopted network application delivery controller %s of order %d", d.Id(), orderId)
+	return true, nil
+}
+
 func findVPXByOrderId(orderId int, meta interface{}) (datatypes.Network_Application_Delivery_Controller, error) {
 	service := services.GetAccountService(meta.(ClientSession).SoftLayerSession())
 
@@ -278,12 +310,7 @@ func findVPXByOrderId(orderId int, meta interface{}) (datatypes.Network_Applicat
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
 		Refresh: func() (interface{}, string, error) {
-			vpxs, err := service.
-				Filter(
-					filter.Build(
-						filter.Path("applicationDeliveryControllers.billingItem.orderItem.order.id").Eq(orderId),
-					),
-				).GetApplicationDeliveryControllers()
+			vpxs, err := getVPXsByOrderId(service, orderId)
 			if err != nil {
 				return datatypes.Network_Application_Delivery_Controller{}, "", err
 			}
@@ -365,7 +392,6 @@ func prepareHardwareOptions(d *schema.ResourceData, meta interface{}) ([]datatyp
 func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(ClientSession).SoftLayerSession()
 
-	productOrderService := services.GetProductOrderService(sess)
 	NADCService := services.GetNetworkApplicationDeliveryControllerService(sess)
 	var err error
 
@@ -402,17 +428,20 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Println("[INFO] Creating network application delivery controller")
 
-	receipt, err := productOrderService.PlaceOrder(&opts, sl.Bool(false))
+	receipt, err := placeOrder(meta, opts.PackageId, &opts)
 
 	if err != nil {
 		return fmt.Errorf("Error creating network application delivery controller: %s", err)
 	}
 
+	setPendingOrderID(d, *receipt.OrderId)
+
 	// Wait VPX provisioning
 	VPX, err := findVPXByOrderId(*receipt.OrderId, meta)
 
 	if err != nil {
-		return fmt.Errorf("Error creating network application delivery controller: %s", err)
+		logPendingOrderWait("network application delivery controller", *receipt.OrderId, err)
+		return nil
 	}
 
 	d.SetId(fmt.Sprintf("%d", *VPX.Id))
@@ -479,6 +508,13 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceIBMLbVpxRead(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(ClientSession).SoftLayerSession()
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingVPX(d, meta)
+		if err != nil || !provisioned {
+			return err
+		}
+	}
+
 	service := services.GetNetworkApplicationDeliveryControllerService(sess)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -580,12 +616,26 @@ func resourceIBMLbVpxDelete(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkApplicationDeliveryControllerService(sess)
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingVPX(d, meta)
+		if err != nil {
+			return err
+		}
+		if !provisioned {
+			return pendingOrderNotProvisionedError("network application delivery controller", d.Id())
+		}
+	}
+
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
 	}
 
 	billingItem, err := service.Id(id).GetBillingItem()
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The network application delivery controller %d was already deleted", id)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("Error deleting network application delivery controller: %s", err)
 	}
@@ -606,6 +656,10 @@ func resourceIBMLbVpxDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMLbVpxExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	if isPendingOrderID(d.Id()) {
+		return true, nil
+	}
+
 	service := services.GetNetworkApplicationDeliveryControllerService(meta.(ClientSession).SoftLayerSession())
 
 	id, err := strconv.Atoi(d.Id())
@@ -0,0 +1,50 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceIBMLbVpxMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found IBM Netscaler VPX State v0; migrating to v1")
+		return migrateLbVpxStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// migrateLbVpxStateV0toV1 moves the subnets of the removed front_end_subnet and back_end_subnet
+// attributes to public_subnet and private_subnet. The removed front_end_vlan and back_end_vlan
+// maps are dropped, since public_vlan_id and private_vlan_id are read from the API.
+func migrateLbVpxStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() || is.Attributes == nil {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+	renamed := map[string]string{
+		"front_end_subnet": "public_subnet",
+		"back_end_subnet":  "private_subnet",
+	}
+	for oldName, newName := range renamed {
+		if v, ok := is.Attributes[oldName]; ok {
+			if _, exists := is.Attributes[newName]; !exists {
+				is.Attributes[newName] = v
+			}
+			delete(is.Attributes, oldName)
+		}
+	}
+	for k := range is.Attributes {
+		if strings.HasPrefix(k, "front_end_vlan.") || strings.HasPrefix(k, "back_end_vlan.") {
+			delete(is.Attributes, k)
+		}
+	}
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
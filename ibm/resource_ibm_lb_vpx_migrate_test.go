@@ -0,0 +1,84 @@
+package ibm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestIBMLbVpxMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		StateVersion int
+		Attributes   map[string]string
+		Expected     map[string]string
+	}{
+		"v0_1": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"name":                                   "test-vpx",
+				"front_end_subnet":                       "23.246.226.248/29",
+				"back_end_subnet":                        "10.107.180.0/26",
+				"front_end_vlan.%":                       "2",
+				"front_end_vlan.vlan_number":             "1144",
+				"front_end_vlan.primary_router_hostname": "fcr01a.dal06",
+				"back_end_vlan.%":                        "2",
+				"back_end_vlan.vlan_number":              "1202",
+				"back_end_vlan.primary_router_hostname":  "bcr01a.dal06",
+			},
+			Expected: map[string]string{
+				"name":           "test-vpx",
+				"public_subnet":  "23.246.226.248/29",
+				"private_subnet": "10.107.180.0/26",
+			},
+		},
+		"v0_1_already_renamed": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"name":           "test-vpx",
+				"public_subnet":  "23.246.226.248/29",
+				"private_subnet": "10.107.180.0/26",
+			},
+			Expected: map[string]string{
+				"name":           "test-vpx",
+				"public_subnet":  "23.246.226.248/29",
+				"private_subnet": "10.107.180.0/26",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "123",
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceIBMLbVpxMigrateState(tc.StateVersion, is, nil)
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if !reflect.DeepEqual(is.Attributes, tc.Expected) {
+			t.Fatalf("bad: %s\n\n expected: %#v -> got: %#v", tn, tc.Expected, is.Attributes)
+		}
+	}
+}
+
+func TestIBMLbVpxMigrateState_empty(t *testing.T) {
+	var is *terraform.InstanceState
+
+	// should handle nil
+	is, err := resourceIBMLbVpxMigrateState(0, is, nil)
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+	if is != nil {
+		t.Fatalf("expected nil instancestate, got: %#v", is)
+	}
+
+	// should handle non-nil but empty
+	is = &terraform.InstanceState{}
+	_, err = resourceIBMLbVpxMigrateState(0, is, nil)
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+}
@@ -0,0 +1,817 @@
+package ibm
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/helpers/product"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const (
+	LbaasPackageType = "LOAD_BALANCER_AS_A_SERVICE"
+
+	lbaasActive  = "ACTIVE"
+	lbaasError   = "ERROR"
+	lbaasPending = "pending"
+	lbaasDeleted = "deleted"
+
+	lbaasMask = "uuid,name,description,operatingStatus,provisioningStatus,isPublic,datacenter[name],ipAddress[ipAddress]," +
+		"listeners[uuid,protocol,protocolPort,connectionLimit,tlsCertificateId," +
+		"defaultPool[protocol,protocolPort,loadBalancingAlgorithm,sessionAffinity[type]]]"
+)
+
+// lbaasLoadBalancingMethods maps the load balancing methods of the configuration to the
+// algorithms of the API
+var lbaasLoadBalancingMethods = map[string]string{
+	"round_robin":          "ROUNDROBIN",
+	"weighted_round_robin": "WEIGHTED_RR",
+	"least_connection":     "LEASTCONNECTION",
+}
+
+// lbaasSSLCipher is a TLS cipher of the load balancers, which the vendored datatypes lack
+type lbaasSSLCipher struct {
+	Id   *int    `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+type lbaasSSLCipherLoadBalancer struct {
+	SslCiphers []lbaasSSLCipher `json:"sslCiphers,omitempty"`
+}
+
+// lbaasLocks serializes the changes of a load balancer, as the API rejects a change while the
+// previous one is still pending
+var lbaasLocks = struct {
+	sync.Mutex
+	m map[string]*sync.Mutex
+}{m: map[string]*sync.Mutex{}}
+
+// lockLbaas blocks until the load balancer can be changed and returns the function releasing it
+func lockLbaas(uuid string) func() {
+	lbaasLocks.Lock()
+	lock, ok := lbaasLocks.m[uuid]
+	if !ok {
+		lock = &sync.Mutex{}
+		lbaasLocks.m[uuid] = lock
+	}
+	lbaasLocks.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+func resourceIBMLbaas() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMLbaasCreate,
+		Read:   resourceIBMLbaasRead,
+		Update: resourceIBMLbaasUpdate,
+		Delete: resourceIBMLbaasDelete,
+		Exists: resourceIBMLbaasExists,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnets": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceIBMLbaasProtocolHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frontend_protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"HTTP", "HTTPS", "TCP"}),
+						},
+						"frontend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validatePortRange(1, 65535),
+						},
+						"backend_protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"HTTP", "TCP"}),
+						},
+						"backend_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validatePortRange(1, 65535),
+						},
+						"load_balancing_method": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "round_robin",
+							ValidateFunc: validateAllowedStringValue([]string{
+								"round_robin", "weighted_round_robin", "least_connection",
+							}),
+						},
+						"session_stickiness": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"SOURCE_IP"}),
+						},
+						"max_conn": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"tls_certificate_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"protocol_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ssl_ciphers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"datacenter": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_time_minutes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  90,
+			},
+		},
+	}
+}
+
+func resourceIBMLbaasCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	name := d.Get("name").(string)
+
+	order, err := buildLbaasProductOrderContainer(d, sess)
+	if err != nil {
+		return fmt.Errorf("Error creating load balancer: %s", err)
+	}
+
+	// The order receipt does not identify the load balancer, so the ordered load balancer is the
+	// one with the name which did not exist before the order
+	existing, err := getLbaasByName(sess, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving load balancers named %s: %s", name, err)
+	}
+	existingUuids := make(map[string]bool, len(existing))
+	for _, lb := range existing {
+		existingUuids[*lb.Uuid] = true
+	}
+
+	log.Println("[INFO] Creating load balancer")
+	receipt, err := placeOrder(meta, order.PackageId, order)
+	if err != nil {
+		return fmt.Errorf("Error during creation of load balancer: %s", err)
+	}
+
+	setPendingOrderID(d, *receipt.OrderId)
+
+	lb, err := waitForLbaasCreation(sess, name, existingUuids, d.Get("wait_time_minutes").(int))
+	if err != nil {
+		logPendingOrderWait("load balancer", *receipt.OrderId, err)
+		return nil
+	}
+	d.SetId(*lb.Uuid)
+	log.Printf("[INFO] Load Balancer ID: %s", d.Id())
+
+	protocols := d.Get("protocols").(*schema.Set).List()
+	for i, p := range protocols {
+		protocols[i] = withLbaasListener(p, "")
+	}
+	if len(protocols) > 0 {
+		err = updateLbaasProtocols(d, meta, protocols)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ciphers, ok := d.GetOk("ssl_ciphers"); ok {
+		err = updateLbaasSSLCiphers(d, meta, ciphers.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMLbaasRead(d, meta)
+}
+
+func resourceIBMLbaasRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingLbaas(d, sess)
+		if err != nil || !provisioned {
+			return err
+		}
+	}
+
+	uuid := d.Id()
+
+	lb, err := services.GetNetworkLBaaSLoadBalancerService(sess).Mask(lbaasMask).GetLoadBalancer(&uuid)
+	if err != nil {
+		if isSoftLayerNotFound(err) {
+			log.Printf("[WARN] Removing load balancer %s from the state because it no longer exists", uuid)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving load balancer %s: %s", uuid, err)
+	}
+
+	d.Set("name", lb.Name)
+	d.Set("description", sl.Get(lb.Description, ""))
+	d.Set("datacenter", sl.Grab(lb, "Datacenter.Name", ""))
+	d.Set("vip", sl.Grab(lb, "IpAddress.IpAddress", ""))
+	d.Set("status", sl.Get(lb.OperatingStatus, ""))
+	if sl.Get(lb.IsPublic, 0).(int) == 1 {
+		d.Set("type", "PUBLIC")
+	} else {
+		d.Set("type", "PRIVATE")
+	}
+	d.Set("protocols", flattenLbaasProtocols(lb.Listeners))
+
+	var ciphers lbaasSSLCipherLoadBalancer
+	err = sess.DoRequest("SoftLayer_Network_LBaaS_LoadBalancer", "getLoadBalancer", []interface{}{uuid},
+		&sl.Options{Mask: "sslCiphers[id,name]"}, &ciphers)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the TLS ciphers of load balancer %s: %s", uuid, err)
+	}
+	cipherNames := make([]string, 0, len(ciphers.SslCiphers))
+	for _, cipher := range ciphers.SslCiphers {
+		cipherNames = append(cipherNames, sl.Get(cipher.Name, "").(string))
+	}
+	d.Set("ssl_ciphers", cipherNames)
+
+	return nil
+}
+
+func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
+	if isPendingOrderID(d.Id()) {
+		return pendingOrderNotProvisionedError("load balancer", d.Id())
+	}
+
+	sess := meta.(ClientSession).SoftLayerSession()
+	uuid := d.Id()
+
+	if d.HasChange("description") {
+		release := lockLbaas(uuid)
+		_, err := waitForLbaasAvailable(sess, uuid, d.Get("wait_time_minutes").(int))
+		if err == nil {
+			description := d.Get("description").(string)
+			_, err = services.GetNetworkLBaaSLoadBalancerService(sess).UpdateLoadBalancer(&uuid, &description)
+		}
+		release()
+		if err != nil {
+			return fmt.Errorf("Error updating the description of load balancer %s: %s", uuid, err)
+		}
+	}
+
+	if d.HasChange("protocols") {
+		o, n := d.GetChange("protocols")
+		oldProtocols := o.(*schema.Set)
+		newProtocols := n.(*schema.Set)
+
+		// A protocol whose frontend port is still configured is updated in place, so that
+		// changes such as the session stickiness keep the listener
+		listenerUuids := map[int]string{}
+		for _, p := range oldProtocols.Difference(newProtocols).List() {
+			protocol := p.(map[string]interface{})
+			listenerUuids[protocol["frontend_port"].(int)] = protocol["protocol_id"].(string)
+		}
+
+		changed := newProtocols.Difference(oldProtocols).List()
+		for i, p := range changed {
+			port := p.(map[string]interface{})["frontend_port"].(int)
+			changed[i] = withLbaasListener(p, listenerUuids[port])
+			delete(listenerUuids, port)
+		}
+
+		if len(listenerUuids) > 0 {
+			removed := make([]string, 0, len(listenerUuids))
+			for _, listenerUuid := range listenerUuids {
+				removed = append(removed, listenerUuid)
+			}
+			err := deleteLbaasProtocols(d, meta, removed)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(changed) > 0 {
+			err := updateLbaasProtocols(d, meta, changed)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("ssl_ciphers") {
+		err := updateLbaasSSLCiphers(d, meta, d.Get("ssl_ciphers").(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMLbaasRead(d, meta)
+}
+
+func resourceIBMLbaasDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	service := services.GetNetworkLBaaSLoadBalancerService(sess)
+
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingLbaas(d, sess)
+		if err != nil {
+			return err
+		}
+		if !provisioned {
+			return pendingOrderNotProvisionedError("load balancer", d.Id())
+		}
+	}
+
+	uuid := d.Id()
+
+	release := lockLbaas(uuid)
+	defer release()
+
+	_, err := service.Mask("uuid").GetLoadBalancer(&uuid)
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The load balancer %s was already deleted", uuid)
+		return nil
+	}
+
+	_, err = waitForLbaasAvailable(sess, uuid, d.Get("wait_time_minutes").(int))
+	if err != nil {
+		return fmt.Errorf("Error waiting for load balancer %s to be available: %s", uuid, err)
+	}
+
+	log.Printf("[INFO] Cancelling load balancer %s", uuid)
+	_, err = service.CancelLoadBalancer(&uuid)
+	if err != nil {
+		return fmt.Errorf("Error cancelling load balancer %s: %s", uuid, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lbaasPending},
+		Target:  []string{lbaasDeleted},
+		Refresh: func() (interface{}, string, error) {
+			lb, err := service.Mask("uuid").GetLoadBalancer(&uuid)
+			if err != nil {
+				if isSoftLayerNotFound(err) {
+					return true, lbaasDeleted, nil
+				}
+				return nil, "", err
+			}
+			return lb, lbaasPending, nil
+		},
+		Timeout:    time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for load balancer %s to be deleted: %s", uuid, err)
+	}
+
+	return nil
+}
+
+func resourceIBMLbaasExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	if isPendingOrderID(d.Id()) {
+		return true, nil
+	}
+
+	sess := meta.(ClientSession).SoftLayerSession()
+	uuid := d.Id()
+
+	_, err := services.GetNetworkLBaaSLoadBalancerService(sess).Mask("uuid").GetLoadBalancer(&uuid)
+	if err != nil {
+		if isSoftLayerNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error communicating with the API: %s", err)
+	}
+	return true, nil
+}
+
+func buildLbaasProductOrderContainer(d *schema.ResourceData, sess *session.Session) (*datatypes.Container_Product_Order_Network_LoadBalancer_AsAService, error) {
+	pkg, err := product.GetPackageByType(sess, LbaasPackageType)
+	if err != nil {
+		return nil, err
+	}
+
+	productItems, err := product.GetPackageProducts(sess, *pkg.Id, "id,keyName,prices[id,locationGroupId]")
+	if err != nil {
+		return nil, err
+	}
+
+	// Select the standard price of the load balancer, which is available in every datacenter
+	var price *datatypes.Product_Item_Price
+	for _, item := range productItems {
+		if sl.Get(item.KeyName, "").(string) != LbaasPackageType {
+			continue
+		}
+		for i := range item.Prices {
+			if item.Prices[i].LocationGroupId == nil {
+				price = &item.Prices[i]
+				break
+			}
+		}
+	}
+	if price == nil {
+		return nil, fmt.Errorf("No product items matching %s could be found", LbaasPackageType)
+	}
+
+	subnetID := d.Get("subnets").([]interface{})[0].(int)
+	subnet, err := services.GetNetworkSubnetService(sess).Id(subnetID).Mask("id,datacenter[id]").GetObject()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving subnet %d: %s", subnetID, err)
+	}
+	if subnet.Datacenter == nil {
+		return nil, fmt.Errorf("Subnet %d has no datacenter", subnetID)
+	}
+
+	return &datatypes.Container_Product_Order_Network_LoadBalancer_AsAService{
+		Name:        sl.String(d.Get("name").(string)),
+		Description: sl.String(d.Get("description").(string)),
+		Subnets:     []datatypes.Network_Subnet{{Id: sl.Int(subnetID)}},
+		Container_Product_Order: datatypes.Container_Product_Order{
+			PackageId: pkg.Id,
+			Location:  sl.String(strconv.Itoa(*subnet.Datacenter.Id)),
+			Prices:    []datatypes.Product_Item_Price{{Id: price.Id}},
+			Quantity:  sl.Int(1),
+		},
+	}, nil
+}
+
+// updateLbaasProtocols adds the protocols to the load balancer, or updates the listeners of the
+// protocols which have a protocol_id
+func updateLbaasProtocols(d *schema.ResourceData, meta interface{}, protocols []interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	uuid := d.Id()
+
+	release := lockLbaas(uuid)
+	defer release()
+
+	_, err := waitForLbaasAvailable(sess, uuid, d.Get("wait_time_minutes").(int))
+	if err != nil {
+		return fmt.Errorf("Error waiting for load balancer %s to be available: %s", uuid, err)
+	}
+
+	_, err = services.GetNetworkLBaaSListenerService(sess).UpdateLoadBalancerProtocols(&uuid, expandLbaasProtocols(protocols))
+	if err != nil {
+		return fmt.Errorf("Error updating the protocols of load balancer %s: %s", uuid, err)
+	}
+
+	_, err = waitForLbaasAvailable(sess, uuid, d.Get("wait_time_minutes").(int))
+	if err != nil {
+		return fmt.Errorf("Error waiting for the protocols of load balancer %s to be updated: %s", uuid, err)
+	}
+	return nil
+}
+
+func deleteLbaasProtocols(d *schema.ResourceData, meta interface{}, listenerUuids []string) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	uuid := d.Id()
+
+	release := lockLbaas(uuid)
+	defer release()
+
+	_, err := waitForLbaasAvailable(sess, uuid, d.Get("wait_time_minutes").(int))
+	if err != nil {
+		return fmt.Errorf("Error waiting for load balancer %s to be available: %s", uuid, err)
+	}
+
+	_, err = services.GetNetworkLBaaSListenerService(sess).DeleteLoadBalancerProtocols(&uuid, listenerUuids)
+	if err != nil {
+		return fmt.Errorf("Error removing protocols from load balancer %s: %s", uuid, err)
+	}
+
+	_, err = waitForLbaasAvailable(sess, uuid, d.Get("wait_time_minutes").(int))
+	if err != nil {
+		return fmt.Errorf("Error waiting for the protocols of load balancer %s to be removed: %s", uuid, err)
+	}
+	return nil
+}
+
+// updateLbaasSSLCiphers sets the TLS ciphers of the HTTPS protocols of the load balancer
+func updateLbaasSSLCiphers(d *schema.ResourceData, meta interface{}, names []interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	uuid := d.Id()
+
+	var ciphers []lbaasSSLCipher
+	err := sess.DoRequest("SoftLayer_Network_LBaaS_SSLCipher", "getAllObjects", nil, &sl.Options{Mask: "id,name"}, &ciphers)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the TLS ciphers of the load balancers: %s", err)
+	}
+	cipherIDs, err := expandLbaasSSLCiphers(names, ciphers)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating the TLS ciphers of load balancer %s", uuid)
+	err = changeLbaas(sess, uuid, d.Get("wait_time_minutes").(int), func() error {
+		var lb interface{}
+		return sess.DoRequest("SoftLayer_Network_LBaaS_LoadBalancer", "updateSslCiphers",
+			[]interface{}{uuid, cipherIDs}, &sl.Options{}, &lb)
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating the TLS ciphers of load balancer %s: %s", uuid, err)
+	}
+	return nil
+}
+
+// expandLbaasSSLCiphers returns the IDs of the TLS ciphers with the names
+func expandLbaasSSLCiphers(names []interface{}, ciphers []lbaasSSLCipher) ([]int, error) {
+	cipherIDs := make(map[string]int, len(ciphers))
+	for _, cipher := range ciphers {
+		cipherIDs[sl.Get(cipher.Name, "").(string)] = sl.Get(cipher.Id, 0).(int)
+	}
+
+	ids := make([]int, 0, len(names))
+	for _, name := range names {
+		id, ok := cipherIDs[name.(string)]
+		if !ok {
+			supported := make([]string, 0, len(cipherIDs))
+			for supportedName := range cipherIDs {
+				supported = append(supported, supportedName)
+			}
+			sort.Strings(supported)
+			return nil, fmt.Errorf("Unknown TLS cipher %s, the supported ciphers are: %s", name, strings.Join(supported, ", "))
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// changeLbaas runs a change of the members or the health monitors once the load balancer is
+// available, and waits for the change to be applied
+func changeLbaas(sess *session.Session, lbaasID string, timeout int, change func() error) error {
+	release := lockLbaas(lbaasID)
+	defer release()
+
+	_, err := waitForLbaasAvailable(sess, lbaasID, timeout)
+	if err != nil {
+		return err
+	}
+	err = change()
+	if err != nil {
+		return err
+	}
+	_, err = waitForLbaasAvailable(sess, lbaasID, timeout)
+	return err
+}
+
+// withLbaasListener returns a copy of the protocol for the listener, or for a new listener when
+// listenerUuid is empty
+func withLbaasListener(p interface{}, listenerUuid string) map[string]interface{} {
+	protocol := p.(map[string]interface{})
+	updated := make(map[string]interface{}, len(protocol))
+	for k, v := range protocol {
+		updated[k] = v
+	}
+	updated["protocol_id"] = listenerUuid
+	return updated
+}
+
+func expandLbaasProtocols(protocols []interface{}) []datatypes.Network_LBaaS_LoadBalancerProtocolConfiguration {
+	configurations := make([]datatypes.Network_LBaaS_LoadBalancerProtocolConfiguration, 0, len(protocols))
+	for _, p := range protocols {
+		protocol := p.(map[string]interface{})
+		configuration := datatypes.Network_LBaaS_LoadBalancerProtocolConfiguration{
+			FrontendProtocol:    sl.String(protocol["frontend_protocol"].(string)),
+			FrontendPort:        sl.Int(protocol["frontend_port"].(int)),
+			BackendProtocol:     sl.String(protocol["backend_protocol"].(string)),
+			BackendPort:         sl.Int(protocol["backend_port"].(int)),
+			LoadBalancingMethod: sl.String(lbaasLoadBalancingMethods[protocol["load_balancing_method"].(string)]),
+		}
+		// The session type is omitted from the request when it is nil, so it is sent empty to
+		// disable the session stickiness of an existing listener
+		if sessionType := protocol["session_stickiness"].(string); sessionType != "" || protocol["protocol_id"].(string) != "" {
+			configuration.SessionType = sl.String(sessionType)
+		}
+		if maxConn := protocol["max_conn"].(int); maxConn > 0 {
+			configuration.MaxConn = sl.Int(maxConn)
+		}
+		if certID := protocol["tls_certificate_id"].(int); certID > 0 {
+			configuration.TlsCertificateId = sl.Int(certID)
+		}
+		if listenerUuid := protocol["protocol_id"].(string); listenerUuid != "" {
+			configuration.ListenerUuid = sl.String(listenerUuid)
+		}
+		configurations = append(configurations, configuration)
+	}
+	return configurations
+}
+
+func flattenLbaasProtocols(listeners []datatypes.Network_LBaaS_Listener) []map[string]interface{} {
+	protocols := make([]map[string]interface{}, 0, len(listeners))
+	for _, listener := range listeners {
+		method := ""
+		algorithm := sl.Grab(listener, "DefaultPool.LoadBalancingAlgorithm", "").(string)
+		for k, v := range lbaasLoadBalancingMethods {
+			if v == algorithm {
+				method = k
+			}
+		}
+		protocols = append(protocols, map[string]interface{}{
+			"frontend_protocol":     sl.Get(listener.Protocol, ""),
+			"frontend_port":         sl.Get(listener.ProtocolPort, 0),
+			"backend_protocol":      sl.Grab(listener, "DefaultPool.Protocol", ""),
+			"backend_port":          sl.Grab(listener, "DefaultPool.ProtocolPort", 0),
+			"load_balancing_method": method,
+			"session_stickiness":    sl.Grab(listener, "DefaultPool.SessionAffinity.Type", ""),
+			"max_conn":              sl.Get(listener.ConnectionLimit, 0),
+			"tls_certificate_id":    sl.Get(listener.TlsCertificateId, 0),
+			"protocol_id":           sl.Get(listener.Uuid, ""),
+		})
+	}
+	return protocols
+}
+
+// resourceIBMLbaasProtocolHash hashes the configured fields of a protocol, so that the
+// protocol_id assigned by the API does not change the hash
+func resourceIBMLbaasProtocolHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["frontend_protocol"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["frontend_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["backend_protocol"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["backend_port"].(int)))
+	if v, ok := m["load_balancing_method"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["session_stickiness"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["max_conn"]; ok && v.(int) > 0 {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	if v, ok := m["tls_certificate_id"]; ok && v.(int) > 0 {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	return hashcode.String(buf.String())
+}
+
+func getLbaasByName(sess *session.Session, name string) ([]datatypes.Network_LBaaS_LoadBalancer, error) {
+	return services.GetNetworkLBaaSLoadBalancerService(sess).
+		Filter(filter.Path("name").Eq(name).Build()).
+		Mask("uuid,provisioningStatus,previousErrorText").
+		GetAllObjects()
+}
+
+// newLbaas returns the load balancers which are not in the existing uuids
+func newLbaas(lbs []datatypes.Network_LBaaS_LoadBalancer, existingUuids map[string]bool) []datatypes.Network_LBaaS_LoadBalancer {
+	result := make([]datatypes.Network_LBaaS_LoadBalancer, 0, len(lbs))
+	for _, lb := range lbs {
+		if lb.Uuid != nil && !existingUuids[*lb.Uuid] {
+			result = append(result, lb)
+		}
+	}
+	return result
+}
+
+// waitForLbaasCreation waits for the ordered load balancer to be provisioned, and returns it. The
+// load balancers with the name which existed before the order are skipped.
+func waitForLbaasCreation(sess *session.Session, name string, existingUuids map[string]bool, timeout int) (datatypes.Network_LBaaS_LoadBalancer, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lbaasPending},
+		Target:  []string{lbaasActive},
+		Refresh: func() (interface{}, string, error) {
+			lbs, err := getLbaasByName(sess, name)
+			if err != nil {
+				return nil, "", err
+			}
+			lbs = newLbaas(lbs, existingUuids)
+			switch len(lbs) {
+			case 0:
+				return nil, lbaasPending, nil
+			case 1:
+				return lbaasProvisioningState(lbs[0])
+			}
+			return nil, "", fmt.Errorf("Expected one new load balancer named %s, found %d", name, len(lbs))
+		},
+		Timeout:    time.Duration(timeout) * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	lb, err := stateConf.WaitForState()
+	if err != nil {
+		return datatypes.Network_LBaaS_LoadBalancer{}, err
+	}
+	return lb.(datatypes.Network_LBaaS_LoadBalancer), nil
+}
+
+// adoptPendingLbaas replaces the pending order id of the load balancer by its uuid once it is
+// provisioned, and tells whether it is. The order receipt does not identify the load balancer, so
+// the ordered load balancer is the one with the name created since the order.
+func adoptPendingLbaas(d *schema.ResourceData, sess *session.Session) (bool, error) {
+	orderId, err := pendingOrderID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	order, err := services.GetBillingOrderService(sess).Id(orderId).Mask("createDate").GetObject()
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving order %d: %s", orderId, err)
+	}
+	name := d.Get("name").(string)
+	lbs, err := services.GetNetworkLBaaSLoadBalancerService(sess).
+		Filter(filter.Path("name").Eq(name).Build()).
+		Mask("uuid,createDate,provisioningStatus").
+		GetAllObjects()
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving load balancers named %s: %s", name, err)
+	}
+
+	ordered := make([]datatypes.Network_LBaaS_LoadBalancer, 0, len(lbs))
+	for _, lb := range lbs {
+		if lb.Uuid != nil && lb.CreateDate != nil && order.CreateDate != nil && !lb.CreateDate.Before(order.CreateDate.Time) {
+			ordered = append(ordered, lb)
+		}
+	}
+	if len(ordered) != 1 || sl.Get(ordered[0].ProvisioningStatus, "").(string) != lbaasActive {
+		log.Printf("[INFO] The load balancer of order %d is not provisioned yet", orderId)
+		return false, nil
+	}
+	d.SetId(*ordered[0].Uuid)
+	log.Printf("[INFO] Adopted load balancer %s of order %d", d.Id(), orderId)
+	return true, nil
+}
+
+// waitForLbaasAvailable waits for the pending changes of the load balancer to be applied
+func waitForLbaasAvailable(sess *session.Session, uuid string, timeout int) (interface{}, error) {
+	service := services.GetNetworkLBaaSLoadBalancerService(sess)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lbaasPending},
+		Target:  []string{lbaasActive},
+		Refresh: func() (interface{}, string, error) {
+			lb, err := service.Mask("uuid,provisioningStatus,previousErrorText").GetLoadBalancer(&uuid)
+			if err != nil {
+				return nil, "", err
+			}
+			return lbaasProvisioningState(lb)
+		},
+		Timeout:    time.Duration(timeout) * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func lbaasProvisioningState(lb datatypes.Network_LBaaS_LoadBalancer) (interface{}, string, error) {
+	switch sl.Get(lb.ProvisioningStatus, "").(string) {
+	case lbaasActive:
+		return lb, lbaasActive, nil
+	case lbaasError:
+		return nil, "", fmt.Errorf("The load balancer is in error: %s", sl.Get(lb.PreviousErrorText, ""))
+	}
+	return lb, lbaasPending, nil
+}
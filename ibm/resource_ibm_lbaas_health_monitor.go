@@ -0,0 +1,319 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// The health monitors of the load balancers are not available in the vendored SoftLayer client,
+// so they are retrieved and updated with DoRequest and the following types
+
+type lbaasHealthMonitor struct {
+	Uuid        *string `json:"uuid,omitempty"`
+	MonitorType *string `json:"monitorType,omitempty"`
+	Interval    *int    `json:"interval,omitempty"`
+	MaxRetries  *int    `json:"maxRetries,omitempty"`
+	Timeout     *int    `json:"timeout,omitempty"`
+	UrlPath     *string `json:"urlPath,omitempty"`
+}
+
+type lbaasHealthMonitorLoadBalancer struct {
+	Listeners []struct {
+		DefaultPool *struct {
+			Protocol      *string             `json:"protocol,omitempty"`
+			ProtocolPort  *int                `json:"protocolPort,omitempty"`
+			HealthMonitor *lbaasHealthMonitor `json:"healthMonitor,omitempty"`
+		} `json:"defaultPool,omitempty"`
+	} `json:"listeners,omitempty"`
+}
+
+type lbaasHealthMonitorConfiguration struct {
+	BackendPort       *int    `json:"backendPort,omitempty"`
+	BackendProtocol   *string `json:"backendProtocol,omitempty"`
+	HealthMonitorUuid *string `json:"healthMonitorUuid,omitempty"`
+	Interval          *int    `json:"interval,omitempty"`
+	MaxRetries        *int    `json:"maxRetries,omitempty"`
+	Timeout           *int    `json:"timeout,omitempty"`
+	UrlPath           *string `json:"urlPath,omitempty"`
+}
+
+// The settings of the health monitors created by the load balancer
+const (
+	lbaasHealthMonitorDefaultInterval   = 5
+	lbaasHealthMonitorDefaultMaxRetries = 2
+	lbaasHealthMonitorDefaultTimeout    = 2
+	lbaasHealthMonitorDefaultURLPath    = "/"
+)
+
+func resourceIBMLbaasHealthMonitor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMLbaasHealthMonitorCreate,
+		Read:   resourceIBMLbaasHealthMonitorRead,
+		Update: resourceIBMLbaasHealthMonitorUpdate,
+		Delete: resourceIBMLbaasHealthMonitorDelete,
+		Exists: resourceIBMLbaasHealthMonitorExists,
+
+		Schema: map[string]*schema.Schema{
+			"lbaas_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"HTTP", "TCP"}),
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePortRange(1, 65535),
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      lbaasHealthMonitorDefaultInterval,
+				ValidateFunc: validateIntegerInRange(2, 60),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      lbaasHealthMonitorDefaultMaxRetries,
+				ValidateFunc: validateIntegerInRange(1, 10),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      lbaasHealthMonitorDefaultTimeout,
+				ValidateFunc: validateIntegerInRange(1, 59),
+			},
+			"url_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lbaasHealthMonitorDefaultURLPath,
+				ValidateFunc: validateURLPath,
+				DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+					// The URL path only applies to the health checks of HTTP
+					return d.Get("protocol").(string) != "HTTP"
+				},
+			},
+			"monitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_time_minutes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  90,
+			},
+		},
+	}
+}
+
+func resourceIBMLbaasHealthMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	lbaasID := d.Get("lbaas_id").(string)
+	protocol := d.Get("protocol").(string)
+	port := d.Get("port").(int)
+
+	// The load balancer creates a health monitor for each backend protocol and port, so the
+	// resource manages the existing health monitor
+	monitor, err := findLbaasHealthMonitor(sess, lbaasID, protocol, port)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the health monitors of load balancer %s: %s", lbaasID, err)
+	}
+	if monitor == nil {
+		return fmt.Errorf("Load balancer %s has no health monitor for the backend protocol %s and port %d", lbaasID, protocol, port)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", lbaasID, *monitor.Uuid))
+
+	err = updateLbaasHealthMonitor(d, sess)
+	if err != nil {
+		return err
+	}
+
+	return resourceIBMLbaasHealthMonitorRead(d, meta)
+}
+
+func resourceIBMLbaasHealthMonitorRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	lbaasID, monitorUUID, err := parseLbaasHealthMonitorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protocol, port, monitor, err := getLbaasHealthMonitor(sess, lbaasID, monitorUUID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the health monitors of load balancer %s: %s", lbaasID, err)
+	}
+	if monitor == nil {
+		return fmt.Errorf("Load balancer %s has no health monitor %s", lbaasID, monitorUUID)
+	}
+
+	d.Set("lbaas_id", lbaasID)
+	d.Set("monitor_id", monitorUUID)
+	d.Set("protocol", protocol)
+	d.Set("port", port)
+	d.Set("interval", sl.Get(monitor.Interval, 0))
+	d.Set("max_retries", sl.Get(monitor.MaxRetries, 0))
+	d.Set("timeout", sl.Get(monitor.Timeout, 0))
+	if protocol == "HTTP" {
+		d.Set("url_path", sl.Get(monitor.UrlPath, lbaasHealthMonitorDefaultURLPath))
+	}
+
+	return nil
+}
+
+func resourceIBMLbaasHealthMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	if d.HasChange("interval") || d.HasChange("max_retries") || d.HasChange("timeout") || d.HasChange("url_path") {
+		err := updateLbaasHealthMonitor(d, sess)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMLbaasHealthMonitorRead(d, meta)
+}
+
+// resourceIBMLbaasHealthMonitorDelete restores the default settings of the health monitor, which
+// is deleted with the protocol of the load balancer.
+func resourceIBMLbaasHealthMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	exists, err := resourceIBMLbaasHealthMonitorExists(d, meta)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	d.Set("interval", lbaasHealthMonitorDefaultInterval)
+	d.Set("max_retries", lbaasHealthMonitorDefaultMaxRetries)
+	d.Set("timeout", lbaasHealthMonitorDefaultTimeout)
+	d.Set("url_path", lbaasHealthMonitorDefaultURLPath)
+	return updateLbaasHealthMonitor(d, sess)
+}
+
+func resourceIBMLbaasHealthMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	sess := meta.(ClientSession).SoftLayerSession()
+	lbaasID, monitorUUID, err := parseLbaasHealthMonitorID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	_, _, monitor, err := getLbaasHealthMonitor(sess, lbaasID, monitorUUID)
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error communicating with the API: %s", err)
+	}
+	return monitor != nil, nil
+}
+
+func updateLbaasHealthMonitor(d *schema.ResourceData, sess *session.Session) error {
+	lbaasID, monitorUUID, err := parseLbaasHealthMonitorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	interval := d.Get("interval").(int)
+	timeout := d.Get("timeout").(int)
+	if timeout >= interval {
+		return fmt.Errorf("The timeout (%d) of the health monitor must be less than its interval (%d)", timeout, interval)
+	}
+
+	configuration := lbaasHealthMonitorConfiguration{
+		BackendProtocol:   sl.String(d.Get("protocol").(string)),
+		BackendPort:       sl.Int(d.Get("port").(int)),
+		HealthMonitorUuid: sl.String(monitorUUID),
+		Interval:          sl.Int(interval),
+		MaxRetries:        sl.Int(d.Get("max_retries").(int)),
+		Timeout:           sl.Int(timeout),
+	}
+	if d.Get("protocol").(string) == "HTTP" {
+		configuration.UrlPath = sl.String(d.Get("url_path").(string))
+	}
+
+	log.Printf("[INFO] Updating health monitor %s of load balancer %s", monitorUUID, lbaasID)
+	err = changeLbaas(sess, lbaasID, d.Get("wait_time_minutes").(int), func() error {
+		var lb interface{}
+		return sess.DoRequest(
+			"SoftLayer_Network_LBaaS_HealthMonitor",
+			"updateLoadBalancerHealthMonitors",
+			[]interface{}{lbaasID, []lbaasHealthMonitorConfiguration{configuration}},
+			&sl.Options{},
+			&lb,
+		)
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating health monitor %s of load balancer %s: %s", monitorUUID, lbaasID, err)
+	}
+	return nil
+}
+
+func getLbaasHealthMonitors(sess *session.Session, lbaasID string) (lbaasHealthMonitorLoadBalancer, error) {
+	var lb lbaasHealthMonitorLoadBalancer
+	err := sess.DoRequest(
+		"SoftLayer_Network_LBaaS_LoadBalancer",
+		"getLoadBalancer",
+		[]interface{}{lbaasID},
+		&sl.Options{Mask: "mask[listeners[defaultPool[protocol,protocolPort,healthMonitor]]]"},
+		&lb,
+	)
+	return lb, err
+}
+
+// getLbaasHealthMonitor returns the backend protocol and port of the health monitor with the given
+// uuid, and the health monitor
+func getLbaasHealthMonitor(sess *session.Session, lbaasID, monitorUUID string) (string, int, *lbaasHealthMonitor, error) {
+	lb, err := getLbaasHealthMonitors(sess, lbaasID)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	for _, listener := range lb.Listeners {
+		pool := listener.DefaultPool
+		if pool == nil || pool.HealthMonitor == nil {
+			continue
+		}
+		if sl.Get(pool.HealthMonitor.Uuid, "").(string) == monitorUUID {
+			return sl.Get(pool.Protocol, "").(string), sl.Get(pool.ProtocolPort, 0).(int), pool.HealthMonitor, nil
+		}
+	}
+	return "", 0, nil, nil
+}
+
+func findLbaasHealthMonitor(sess *session.Session, lbaasID, protocol string, port int) (*lbaasHealthMonitor, error) {
+	lb, err := getLbaasHealthMonitors(sess, lbaasID)
+	if err != nil {
+		return nil, err
+	}
+	for _, listener := range lb.Listeners {
+		pool := listener.DefaultPool
+		if pool == nil || pool.HealthMonitor == nil {
+			continue
+		}
+		if sl.Get(pool.Protocol, "").(string) == protocol && sl.Get(pool.ProtocolPort, 0).(int) == port {
+			return pool.HealthMonitor, nil
+		}
+	}
+	return nil, nil
+}
+
+func parseLbaasHealthMonitorID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Not a valid health monitor ID, must be <lbaas_id>:<monitor_id>: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
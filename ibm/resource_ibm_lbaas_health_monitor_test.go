@@ -0,0 +1,117 @@
+package ibm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIBMLbaasHealthMonitor_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraformuat_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMLbaasDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMLbaasHealthMonitorConfig(name, 10, "/health"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_lbaas_health_monitor.lbaas_hm", "interval", "10"),
+					resource.TestCheckResourceAttr("ibm_lbaas_health_monitor.lbaas_hm", "url_path", "/health"),
+					resource.TestCheckResourceAttrSet("ibm_lbaas_health_monitor.lbaas_hm", "monitor_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMLbaasHealthMonitorConfig(name, 20, "/status"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_lbaas_health_monitor.lbaas_hm", "interval", "20"),
+					resource.TestCheckResourceAttr("ibm_lbaas_health_monitor.lbaas_hm", "url_path", "/status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMLbaasHealthMonitor_Defaults(t *testing.T) {
+	name := fmt.Sprintf("terraformuat_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMLbaasDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMLbaasHealthMonitorConfig(name, 10, "/health"),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMLbaasHealthMonitorConfigDefaults(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_lbaas_health_monitor.lbaas_hm", "interval", "5"),
+					resource.TestCheckResourceAttr("ibm_lbaas_health_monitor.lbaas_hm", "max_retries", "2"),
+					resource.TestCheckResourceAttr("ibm_lbaas_health_monitor.lbaas_hm", "timeout", "2"),
+					resource.TestCheckResourceAttr("ibm_lbaas_health_monitor.lbaas_hm", "url_path", "/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMLbaasHealthMonitor_InvalidTimeout(t *testing.T) {
+	name := fmt.Sprintf("terraformuat_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMLbaasDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckIBMLbaasHealthMonitorConfig(name, 2, "/health"),
+				ExpectError: regexp.MustCompile("must be less than its interval"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMLbaasHealthMonitorConfigDefaults(name string) string {
+	return fmt.Sprintf(`
+resource "ibm_lbaas" "lbaas" {
+  name    = "%s"
+  subnets = [%s]
+  protocols = [{
+    frontend_protocol = "HTTP"
+    frontend_port     = 80
+    backend_protocol  = "HTTP"
+    backend_port      = 80
+  }]
+}
+
+resource "ibm_lbaas_health_monitor" "lbaas_hm" {
+  lbaas_id = "${ibm_lbaas.lbaas.id}"
+  protocol = "HTTP"
+  port     = 80
+}`, name, lbaasSubnetID)
+}
+
+func testAccCheckIBMLbaasHealthMonitorConfig(name string, interval int, urlPath string) string {
+	return fmt.Sprintf(`
+resource "ibm_lbaas" "lbaas" {
+  name    = "%s"
+  subnets = [%s]
+  protocols = [{
+    frontend_protocol = "HTTP"
+    frontend_port     = 80
+    backend_protocol  = "HTTP"
+    backend_port      = 80
+  }]
+}
+
+resource "ibm_lbaas_health_monitor" "lbaas_hm" {
+  lbaas_id = "${ibm_lbaas.lbaas.id}"
+  protocol = "HTTP"
+  port     = 80
+  interval = %d
+  timeout  = 2
+  url_path = "%s"
+}`, name, lbaasSubnetID, interval, urlPath)
+}
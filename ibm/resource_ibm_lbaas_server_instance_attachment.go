@@ -0,0 +1,214 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func resourceIBMLbaasServerInstanceAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMLbaasServerInstanceAttachmentCreate,
+		Read:     resourceIBMLbaasServerInstanceAttachmentRead,
+		Update:   resourceIBMLbaasServerInstanceAttachmentUpdate,
+		Delete:   resourceIBMLbaasServerInstanceAttachmentDelete,
+		Exists:   resourceIBMLbaasServerInstanceAttachmentExists,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"private_ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateIntegerInRange(0, 100),
+			},
+			"lbaas_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_time_minutes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  90,
+			},
+		},
+	}
+}
+
+func resourceIBMLbaasServerInstanceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	lbaasID := d.Get("lbaas_id").(string)
+	privateIP := d.Get("private_ip_address").(string)
+
+	member := datatypes.Network_LBaaS_LoadBalancerServerInstanceInfo{
+		PrivateIpAddress: sl.String(privateIP),
+	}
+	if weight, ok := d.GetOk("weight"); ok {
+		member.Weight = sl.Int(weight.(int))
+	}
+
+	err := changeLbaas(sess, lbaasID, d.Get("wait_time_minutes").(int), func() error {
+		_, err := services.GetNetworkLBaaSMemberService(sess).AddLoadBalancerMembers(
+			&lbaasID, []datatypes.Network_LBaaS_LoadBalancerServerInstanceInfo{member})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error attaching server instance %s to load balancer %s: %s", privateIP, lbaasID, err)
+	}
+
+	m, err := findLbaasMemberByAddress(sess, lbaasID, privateIP)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("Server instance %s is not attached to load balancer %s", privateIP, lbaasID)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", lbaasID, *m.Uuid))
+	log.Printf("[INFO] Attached server instance %s to load balancer %s", privateIP, lbaasID)
+
+	return resourceIBMLbaasServerInstanceAttachmentRead(d, meta)
+}
+
+func resourceIBMLbaasServerInstanceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	lbaasID, memberUUID, err := parseLbaasMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	m, err := findLbaasMember(sess, lbaasID, memberUUID)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("Server instance %s is not attached to load balancer %s", memberUUID, lbaasID)
+	}
+
+	d.Set("lbaas_id", lbaasID)
+	d.Set("uuid", memberUUID)
+	d.Set("private_ip_address", sl.Get(m.Address, ""))
+	d.Set("weight", sl.Get(m.Weight, 0))
+
+	return nil
+}
+
+func resourceIBMLbaasServerInstanceAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	lbaasID, memberUUID, err := parseLbaasMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("weight") {
+		member := datatypes.Network_LBaaS_Member{
+			Uuid:   sl.String(memberUUID),
+			Weight: sl.Int(d.Get("weight").(int)),
+		}
+		err := changeLbaas(sess, lbaasID, d.Get("wait_time_minutes").(int), func() error {
+			_, err := services.GetNetworkLBaaSMemberService(sess).UpdateLoadBalancerMembers(
+				&lbaasID, []datatypes.Network_LBaaS_Member{member})
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating the weight of server instance %s of load balancer %s: %s", memberUUID, lbaasID, err)
+		}
+	}
+
+	return resourceIBMLbaasServerInstanceAttachmentRead(d, meta)
+}
+
+func resourceIBMLbaasServerInstanceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	lbaasID, memberUUID, err := parseLbaasMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Detaching server instance %s from load balancer %s", memberUUID, lbaasID)
+	err = changeLbaas(sess, lbaasID, d.Get("wait_time_minutes").(int), func() error {
+		_, err := services.GetNetworkLBaaSMemberService(sess).DeleteLoadBalancerMembers(&lbaasID, []string{memberUUID})
+		return err
+	})
+	if err != nil && !isSoftLayerNotFound(err) {
+		return fmt.Errorf("Error detaching server instance %s from load balancer %s: %s", memberUUID, lbaasID, err)
+	}
+
+	return nil
+}
+
+func resourceIBMLbaasServerInstanceAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	sess := meta.(ClientSession).SoftLayerSession()
+	lbaasID, memberUUID, err := parseLbaasMemberID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	m, err := findLbaasMember(sess, lbaasID, memberUUID)
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error communicating with the API: %s", err)
+	}
+	return m != nil, nil
+}
+
+func getLbaasMembers(sess *session.Session, lbaasID string) ([]datatypes.Network_LBaaS_Member, error) {
+	lb, err := services.GetNetworkLBaaSLoadBalancerService(sess).
+		Mask("members[uuid,address,weight]").
+		GetLoadBalancer(&lbaasID)
+	if err != nil {
+		return nil, err
+	}
+	return lb.Members, nil
+}
+
+func findLbaasMember(sess *session.Session, lbaasID, memberUUID string) (*datatypes.Network_LBaaS_Member, error) {
+	members, err := getLbaasMembers(sess, lbaasID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range members {
+		if sl.Get(members[i].Uuid, "").(string) == memberUUID {
+			return &members[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func findLbaasMemberByAddress(sess *session.Session, lbaasID, address string) (*datatypes.Network_LBaaS_Member, error) {
+	members, err := getLbaasMembers(sess, lbaasID)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the members of load balancer %s: %s", lbaasID, err)
+	}
+	for i := range members {
+		if sl.Get(members[i].Address, "").(string) == address {
+			return &members[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func parseLbaasMemberID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Not a valid server instance attachment ID, must be <lbaas_id>:<uuid>: %s", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,98 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIBMLbaasServerInstanceAttachment_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraformuat_%d", acctest.RandInt())
+	hostname := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMLbaasServerInstanceAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMLbaasServerInstanceAttachmentConfig(name, hostname, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_lbaas_server_instance_attachment.member", "private_ip_address",
+						"ibm_compute_vm_instance.lbaasvm", "ipv4_address_private"),
+					resource.TestCheckResourceAttr(
+						"ibm_lbaas_server_instance_attachment.member", "weight", "20"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_lbaas_server_instance_attachment.member", "uuid"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMLbaasServerInstanceAttachmentConfig(name, hostname, 40),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_lbaas_server_instance_attachment.member", "weight", "40"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMLbaasServerInstanceAttachmentDestroy(s *terraform.State) error {
+	sess := testAccProvider.Meta().(ClientSession).SoftLayerSession()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_lbaas_server_instance_attachment" {
+			continue
+		}
+
+		lbaasID, memberUUID, err := parseLbaasMemberID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		m, err := findLbaasMember(sess, lbaasID, memberUUID)
+		if err == nil && m != nil {
+			return fmt.Errorf("Server instance %s is still attached to load balancer %s", memberUUID, lbaasID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMLbaasServerInstanceAttachmentConfig(name, hostname string, weight int) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_vm_instance" "lbaasvm" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "dal06"
+    network_speed = 10
+    hourly_billing = true
+    private_network_only = false
+    cores = 1
+    memory = 1024
+    disks = [25]
+    local_disk = false
+}
+
+resource "ibm_lbaas" "lbaas" {
+  name    = "%s"
+  subnets = [%s]
+  protocols = [{
+    frontend_protocol     = "HTTP"
+    frontend_port         = 80
+    backend_protocol      = "HTTP"
+    backend_port          = 80
+    load_balancing_method = "weighted_round_robin"
+  }]
+}
+
+resource "ibm_lbaas_server_instance_attachment" "member" {
+  private_ip_address = "${ibm_compute_vm_instance.lbaasvm.ipv4_address_private}"
+  weight             = %d
+  lbaas_id           = "${ibm_lbaas.lbaas.id}"
+}`, hostname, name, lbaasSubnetID, weight)
+}
@@ -0,0 +1,294 @@
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMLbaas_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraformuat_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMLbaasDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMLbaasConfig(name, "desc-used-for-terraform-uat"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_lbaas.lbaas", "name", name),
+					resource.TestCheckResourceAttr("ibm_lbaas.lbaas", "description", "desc-used-for-terraform-uat"),
+					resource.TestCheckResourceAttr("ibm_lbaas.lbaas", "subnets.0", lbaasSubnetID),
+					resource.TestCheckResourceAttr("ibm_lbaas.lbaas", "protocols.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_lbaas.lbaas", "vip"),
+					resource.TestCheckResourceAttrSet("ibm_lbaas.lbaas", "datacenter"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMLbaasConfigUpdate(name, "updated-desc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_lbaas.lbaas", "description", "updated-desc"),
+					resource.TestCheckResourceAttr("ibm_lbaas.lbaas", "protocols.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMLbaas_SessionStickiness(t *testing.T) {
+	name := fmt.Sprintf("terraformuat_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMLbaasDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMLbaasConfig(name, "stickiness"),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMLbaasConfigSessionStickiness(name, "stickiness"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_lbaas.lbaas", "protocols.#", "1"),
+					testAccCheckIBMLbaasProtocolSessionStickiness("ibm_lbaas.lbaas", "SOURCE_IP"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMLbaas_InvalidProtocol(t *testing.T) {
+	name := fmt.Sprintf("terraformuat_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(`
+resource "ibm_lbaas" "lbaas" {
+  name    = "%s"
+  subnets = [%s]
+  protocols = [{
+    frontend_protocol = "UDP"
+    frontend_port     = 80
+    backend_protocol  = "HTTP"
+    backend_port      = 80
+  }]
+}`, name, lbaasSubnetID),
+				ExpectError: regexp.MustCompile("frontend_protocol"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMLbaasDestroy(s *terraform.State) error {
+	service := services.GetNetworkLBaaSLoadBalancerService(testAccProvider.Meta().(ClientSession).SoftLayerSession())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_lbaas" {
+			continue
+		}
+
+		uuid := rs.Primary.ID
+		_, err := service.GetLoadBalancer(&uuid)
+		if err == nil {
+			return fmt.Errorf("Load balancer %s still exists", uuid)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMLbaasProtocolSessionStickiness(n, sessionType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if regexp.MustCompile(`^protocols\.\d+\.session_stickiness$`).MatchString(k) && v == sessionType {
+				return nil
+			}
+		}
+		return fmt.Errorf("No protocol of %s has the session stickiness %s", n, sessionType)
+	}
+}
+
+func testAccCheckIBMLbaasConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "ibm_lbaas" "lbaas" {
+  name        = "%s"
+  description = "%s"
+  subnets     = [%s]
+  protocols = [{
+    frontend_protocol     = "HTTP"
+    frontend_port         = 80
+    backend_protocol      = "HTTP"
+    backend_port          = 80
+    load_balancing_method = "round_robin"
+  }]
+}`, name, description, lbaasSubnetID)
+}
+
+func testAccCheckIBMLbaasConfigUpdate(name, description string) string {
+	return fmt.Sprintf(`
+resource "ibm_lbaas" "lbaas" {
+  name        = "%s"
+  description = "%s"
+  subnets     = [%s]
+  protocols = [{
+    frontend_protocol     = "HTTP"
+    frontend_port         = 80
+    backend_protocol      = "HTTP"
+    backend_port          = 80
+    load_balancing_method = "round_robin"
+  },
+  {
+    frontend_protocol     = "TCP"
+    frontend_port         = 9443
+    backend_protocol      = "TCP"
+    backend_port          = 9443
+    load_balancing_method = "least_connection"
+    session_stickiness    = "SOURCE_IP"
+  }]
+}`, name, description, lbaasSubnetID)
+}
+
+func testAccCheckIBMLbaasConfigSessionStickiness(name, description string) string {
+	return fmt.Sprintf(`
+resource "ibm_lbaas" "lbaas" {
+  name        = "%s"
+  description = "%s"
+  subnets     = [%s]
+  protocols = [{
+    frontend_protocol     = "HTTP"
+    frontend_port         = 80
+    backend_protocol      = "HTTP"
+    backend_port          = 80
+    load_balancing_method = "round_robin"
+    session_stickiness    = "SOURCE_IP"
+  }]
+}`, name, description, lbaasSubnetID)
+}
+
+func TestExpandLbaasProtocols(t *testing.T) {
+	protocols := []interface{}{
+		map[string]interface{}{
+			"frontend_protocol":     "HTTPS",
+			"frontend_port":         443,
+			"backend_protocol":      "HTTP",
+			"backend_port":          80,
+			"load_balancing_method": "least_connection",
+			"session_stickiness":    "SOURCE_IP",
+			"max_conn":              0,
+			"tls_certificate_id":    1234,
+			"protocol_id":           "a1b2",
+		},
+		map[string]interface{}{
+			"frontend_protocol":     "TCP",
+			"frontend_port":         9443,
+			"backend_protocol":      "TCP",
+			"backend_port":          9443,
+			"load_balancing_method": "round_robin",
+			"session_stickiness":    "",
+			"max_conn":              100,
+			"tls_certificate_id":    0,
+			"protocol_id":           "",
+		},
+		map[string]interface{}{
+			"frontend_protocol":     "HTTP",
+			"frontend_port":         80,
+			"backend_protocol":      "HTTP",
+			"backend_port":          80,
+			"load_balancing_method": "round_robin",
+			"session_stickiness":    "",
+			"max_conn":              0,
+			"tls_certificate_id":    0,
+			"protocol_id":           "c3d4",
+		},
+	}
+
+	configurations := expandLbaasProtocols(protocols)
+	if len(configurations) != 3 {
+		t.Fatalf("Expected 3 configurations, got %d", len(configurations))
+	}
+
+	https := configurations[0]
+	if *https.LoadBalancingMethod != "LEASTCONNECTION" {
+		t.Errorf("Expected load balancing method LEASTCONNECTION, got %s", *https.LoadBalancingMethod)
+	}
+	if https.SessionType == nil || *https.SessionType != "SOURCE_IP" {
+		t.Errorf("Expected session type SOURCE_IP, got %v", https.SessionType)
+	}
+	if https.TlsCertificateId == nil || *https.TlsCertificateId != 1234 {
+		t.Errorf("Expected TLS certificate 1234, got %v", https.TlsCertificateId)
+	}
+	if https.ListenerUuid == nil || *https.ListenerUuid != "a1b2" {
+		t.Errorf("Expected listener a1b2, got %v", https.ListenerUuid)
+	}
+	if https.MaxConn != nil {
+		t.Errorf("Expected no max connections, got %d", *https.MaxConn)
+	}
+
+	tcp := configurations[1]
+	if tcp.SessionType != nil {
+		t.Errorf("Expected no session type, got %s", *tcp.SessionType)
+	}
+	if tcp.ListenerUuid != nil {
+		t.Errorf("Expected a new listener, got %s", *tcp.ListenerUuid)
+	}
+	if tcp.MaxConn == nil || *tcp.MaxConn != 100 {
+		t.Errorf("Expected 100 max connections, got %v", tcp.MaxConn)
+	}
+
+	// The session stickiness of an existing listener is disabled with an empty session type
+	plain := configurations[2]
+	if plain.SessionType == nil || *plain.SessionType != "" {
+		t.Errorf("Expected an empty session type, got %v", plain.SessionType)
+	}
+	body, _ := json.Marshal(plain)
+	if !strings.Contains(string(body), `"sessionType":""`) {
+		t.Errorf("Expected the empty session type to be sent, got %s", body)
+	}
+}
+
+func TestExpandLbaasSSLCiphers(t *testing.T) {
+	ciphers := []lbaasSSLCipher{
+		{Id: sl.Int(2), Name: sl.String("ECDHE-RSA-AES256-GCM-SHA384")},
+		{Id: sl.Int(5), Name: sl.String("AES128-SHA")},
+	}
+
+	ids, err := expandLbaasSSLCiphers([]interface{}{"ECDHE-RSA-AES256-GCM-SHA384"}, ciphers)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(ids) != 1 || ids[0] != 2 {
+		t.Errorf("Expected the cipher 2, got %v", ids)
+	}
+
+	_, err = expandLbaasSSLCiphers([]interface{}{"RC4-MD5"}, ciphers)
+	if err == nil || !strings.Contains(err.Error(), "AES128-SHA, ECDHE-RSA-AES256-GCM-SHA384") {
+		t.Errorf("Expected an unknown cipher error listing the supported ciphers, got %v", err)
+	}
+}
+
+func TestNewLbaas(t *testing.T) {
+	lbs := []datatypes.Network_LBaaS_LoadBalancer{
+		{Uuid: sl.String("old")},
+		{Uuid: sl.String("new")},
+	}
+
+	result := newLbaas(lbs, map[string]bool{"old": true})
+	if len(result) != 1 || *result[0].Uuid != "new" {
+		t.Fatalf("Expected only the new load balancer, got %v", result)
+	}
+}
@@ -0,0 +1,225 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func resourceIBMNetworkGatewayVlanAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMNetworkGatewayVlanAttachmentCreate,
+		Read:     resourceIBMNetworkGatewayVlanAttachmentRead,
+		Update:   resourceIBMNetworkGatewayVlanAttachmentUpdate,
+		Delete:   resourceIBMNetworkGatewayVlanAttachmentDelete,
+		Exists:   resourceIBMNetworkGatewayVlanAttachmentExists,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"gateway_id": {
+				Description: "The ID of the network gateway",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"network_vlan_id": {
+				Description: "The ID of the VLAN attached to the network gateway",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"bypass": {
+				Description: "Whether the traffic of the VLAN bypasses the network gateway instead of being routed through it",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func resourceIBMNetworkGatewayVlanAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	gatewayID := d.Get("gateway_id").(int)
+	vlanID := d.Get("network_vlan_id").(int)
+
+	// The VLAN is always attached in bypass mode first, and only routed through the gateway once it
+	// is attached, so that its traffic is never routed through a half configured gateway
+	log.Printf("[INFO] Attaching vlan %d to network gateway %d", vlanID, gatewayID)
+	gatewayVlan, err := services.GetNetworkGatewayVlanService(sess).CreateObject(&datatypes.Network_Gateway_Vlan{
+		NetworkGatewayId: sl.Int(gatewayID),
+		NetworkVlanId:    sl.Int(vlanID),
+		BypassFlag:       sl.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("Error attaching vlan %d to network gateway %d: %s", vlanID, gatewayID, err)
+	}
+
+	d.SetId(strconv.Itoa(*gatewayVlan.Id))
+	log.Printf("[INFO] Network gateway vlan ID: %s", d.Id())
+
+	err = waitForGatewayVlanBypass(sess, *gatewayVlan.Id, true)
+	if err != nil {
+		return err
+	}
+
+	if !d.Get("bypass").(bool) {
+		err = setGatewayVlanBypass(sess, *gatewayVlan.Id, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMNetworkGatewayVlanAttachmentRead(d, meta)
+}
+
+func resourceIBMNetworkGatewayVlanAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+	}
+
+	gatewayVlan, err := services.GetNetworkGatewayVlanService(sess).Id(id).
+		Mask("id,networkGatewayId,networkVlanId,bypassFlag").GetObject()
+	if err != nil {
+		return fmt.Errorf("Error retrieving network gateway vlan %d: %s", id, err)
+	}
+
+	d.Set("gateway_id", sl.Get(gatewayVlan.NetworkGatewayId, 0))
+	d.Set("network_vlan_id", sl.Get(gatewayVlan.NetworkVlanId, 0))
+	d.Set("bypass", sl.Get(gatewayVlan.BypassFlag, false))
+
+	return nil
+}
+
+func resourceIBMNetworkGatewayVlanAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+	}
+
+	if d.HasChange("bypass") {
+		err = setGatewayVlanBypass(sess, id, d.Get("bypass").(bool))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMNetworkGatewayVlanAttachmentRead(d, meta)
+}
+
+func resourceIBMNetworkGatewayVlanAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+	}
+
+	// The VLAN is bypassed before it is detached, so that its traffic is not interrupted while the
+	// gateway removes the VLAN
+	gatewayVlan, err := services.GetNetworkGatewayVlanService(sess).Id(id).Mask("id,bypassFlag").GetObject()
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The network gateway vlan %d was already detached", id)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error retrieving network gateway vlan %d: %s", id, err)
+	}
+	if !sl.Get(gatewayVlan.BypassFlag, false).(bool) {
+		err = setGatewayVlanBypass(sess, id, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Detaching network gateway vlan %d", id)
+	err = services.GetNetworkGatewayVlanService(sess).Id(id).DeleteObject()
+	if err != nil {
+		return fmt.Errorf("Error detaching network gateway vlan %d: %s", id, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMNetworkGatewayVlanAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+	}
+
+	_, err = services.GetNetworkGatewayVlanService(sess).Id(id).Mask("id").GetObject()
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error retrieving network gateway vlan %d: %s", id, err)
+	}
+	return true, nil
+}
+
+// setGatewayVlanBypass bypasses the network gateway for the VLAN, or routes the VLAN through the
+// gateway, and waits for the change to be applied
+func setGatewayVlanBypass(sess *session.Session, id int, bypass bool) error {
+	service := services.GetNetworkGatewayVlanService(sess).Id(id)
+
+	var err error
+	if bypass {
+		log.Printf("[INFO] Bypassing the network gateway for network gateway vlan %d", id)
+		err = service.Bypass()
+	} else {
+		log.Printf("[INFO] Routing network gateway vlan %d through the network gateway", id)
+		err = service.Unbypass()
+	}
+	if err != nil {
+		return fmt.Errorf("Error changing the bypass of network gateway vlan %d: %s", id, err)
+	}
+
+	return waitForGatewayVlanBypass(sess, id, bypass)
+}
+
+func waitForGatewayVlanBypass(sess *session.Session, id int, bypass bool) error {
+	target := "routed"
+	if bypass {
+		target = "bypassed"
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			gatewayVlan, err := services.GetNetworkGatewayVlanService(sess).Id(id).Mask("id,bypassFlag").GetObject()
+			if err != nil {
+				return nil, "", err
+			}
+			if gatewayVlan.BypassFlag != nil && *gatewayVlan.BypassFlag == bypass {
+				return gatewayVlan, target, nil
+			}
+			return gatewayVlan, "pending", nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for network gateway vlan %d to be %s: %s", id, target, err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package ibm
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/softlayer/softlayer-go/services"
+)
+
+func TestAccIBMNetworkGatewayVlanAttachment_Basic(t *testing.T) {
+	hostname := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMNetworkGatewayVlanAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMNetworkGatewayVlanAttachmentConfig(hostname, networkGatewayName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_network_gateway_vlan_attachment.attachment", "gateway_id",
+						"data.ibm_vpn_gateway.gateway", "id"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_network_gateway_vlan_attachment.attachment", "network_vlan_id",
+						"ibm_compute_vm_instance.gatewayvm", "private_vlan_id"),
+					resource.TestCheckResourceAttr(
+						"ibm_network_gateway_vlan_attachment.attachment", "bypass", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMNetworkGatewayVlanAttachmentConfig(hostname, networkGatewayName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_network_gateway_vlan_attachment.attachment", "bypass", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_network_gateway_vlan_attachment.attachment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMNetworkGatewayVlanAttachmentDestroy(s *terraform.State) error {
+	sess := testAccProvider.Meta().(ClientSession).SoftLayerSession()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_network_gateway_vlan_attachment" {
+			continue
+		}
+
+		id, _ := strconv.Atoi(rs.Primary.ID)
+		_, err := services.GetNetworkGatewayVlanService(sess).Id(id).GetObject()
+		if err == nil {
+			return fmt.Errorf("Network gateway vlan %d still exists", id)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMNetworkGatewayVlanAttachmentConfig(hostname, gatewayName string, bypass bool) string {
+	return fmt.Sprintf(`
+data "ibm_vpn_gateway" "gateway" {
+    name = "%s"
+}
+
+resource "ibm_compute_vm_instance" "gatewayvm" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "dal06"
+    network_speed = 10
+    hourly_billing = true
+    private_network_only = false
+    cores = 1
+    memory = 1024
+    disks = [25]
+    local_disk = false
+}
+
+resource "ibm_network_gateway_vlan_attachment" "attachment" {
+    gateway_id = "${data.ibm_vpn_gateway.gateway.id}"
+    network_vlan_id = "${ibm_compute_vm_instance.gatewayvm.private_vlan_id}"
+    bypass = %t
+}`, gatewayName, hostname, bypass)
+}
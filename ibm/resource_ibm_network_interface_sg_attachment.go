@@ -0,0 +1,175 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func resourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMNetworkInterfaceSGAttachmentCreate,
+		Read:     resourceIBMNetworkInterfaceSGAttachmentRead,
+		Delete:   resourceIBMNetworkInterfaceSGAttachmentDelete,
+		Exists:   resourceIBMNetworkInterfaceSGAttachmentExists,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	sgID := d.Get("security_group_id").(int)
+	interfaceID := d.Get("network_interface_id").(int)
+
+	log.Printf("[INFO] Attaching security group %d to network interface %d", sgID, interfaceID)
+	_, err := services.GetNetworkSecurityGroupService(sess).Id(sgID).AttachNetworkComponents([]int{interfaceID})
+	if err != nil {
+		return fmt.Errorf("Error attaching security group %d to network interface %d: %s", sgID, interfaceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d", sgID, interfaceID))
+
+	err = waitForSecurityGroupAttachment(sess, sgID, interfaceID, true)
+	if err != nil {
+		return fmt.Errorf("Error waiting for security group %d to be attached to network interface %d: %s", sgID, interfaceID, err)
+	}
+
+	return resourceIBMNetworkInterfaceSGAttachmentRead(d, meta)
+}
+
+func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	sgID, interfaceID, err := parseSGAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("security_group_id", sgID)
+	d.Set("network_interface_id", interfaceID)
+
+	return nil
+}
+
+func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	sgID, interfaceID, err := parseSGAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Detaching security group %d from network interface %d", sgID, interfaceID)
+	_, err = services.GetNetworkSecurityGroupService(sess).Id(sgID).DetachNetworkComponents([]int{interfaceID})
+	if err != nil && !isSoftLayerNotFound(err) {
+		return fmt.Errorf("Error detaching security group %d from network interface %d: %s", sgID, interfaceID, err)
+	}
+
+	// The interface can't be attached to the security group again until the detach completes
+	err = waitForSecurityGroupAttachment(sess, sgID, interfaceID, false)
+	if err != nil && !isSoftLayerNotFound(err) {
+		return fmt.Errorf("Error waiting for security group %d to be detached from network interface %d: %s", sgID, interfaceID, err)
+	}
+
+	return nil
+}
+
+func resourceIBMNetworkInterfaceSGAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	sess := meta.(ClientSession).SoftLayerSession()
+	sgID, interfaceID, err := parseSGAttachmentID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	attached, err := isSecurityGroupAttached(sess, sgID, interfaceID)
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error retrieving security group %d: %s", sgID, err)
+	}
+
+	return attached, nil
+}
+
+// waitForSecurityGroupAttachment waits until the network interface is attached to the security
+// group, or detached from it
+func waitForSecurityGroupAttachment(sess *session.Session, sgID, interfaceID int, attach bool) error {
+	target := "detached"
+	if attach {
+		target = "attached"
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			attached, err := isSecurityGroupAttached(sess, sgID, interfaceID)
+			if err != nil {
+				return nil, "", err
+			}
+			if attached == attach {
+				return attached, target, nil
+			}
+			return attached, "pending", nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func isSecurityGroupAttached(sess *session.Session, sgID, interfaceID int) (bool, error) {
+	bindings, err := services.GetNetworkSecurityGroupService(sess).
+		Id(sgID).
+		Mask("networkComponentId").
+		GetNetworkComponentBindings()
+	if err != nil {
+		return false, err
+	}
+
+	for _, binding := range bindings {
+		if binding.NetworkComponentId != nil && *binding.NetworkComponentId == interfaceID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func parseSGAttachmentID(id string) (int, int, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("Not a valid security group attachment ID, must be <security_group_id>:<network_interface_id>: %s", id)
+	}
+
+	sgID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Not a valid security group ID, must be an integer: %s", err)
+	}
+	interfaceID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Not a valid network interface ID, must be an integer: %s", err)
+	}
+
+	return sgID, interfaceID, nil
+}
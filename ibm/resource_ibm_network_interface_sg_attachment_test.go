@@ -0,0 +1,75 @@
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIBMNetworkInterfaceSGAttachment_Basic(t *testing.T) {
+	hostname := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMNetworkInterfaceSGAttachmentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMNetworkInterfaceSGAttachmentConfig(hostname, securityGroupID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_network_interface_sg_attachment.sg", "security_group_id", securityGroupID),
+					resource.TestCheckResourceAttrPair(
+						"ibm_network_interface_sg_attachment.sg", "network_interface_id",
+						"ibm_compute_vm_instance.sgvm", "private_interface_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMNetworkInterfaceSGAttachmentDestroy(s *terraform.State) error {
+	sess := testAccProvider.Meta().(ClientSession).SoftLayerSession()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_network_interface_sg_attachment" {
+			continue
+		}
+
+		sgID, interfaceID, err := parseSGAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		attached, err := isSecurityGroupAttached(sess, sgID, interfaceID)
+		if err == nil && attached {
+			return fmt.Errorf("Security group %d is still attached to network interface %d", sgID, interfaceID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMNetworkInterfaceSGAttachmentConfig(hostname, sgID string) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_vm_instance" "sgvm" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "dal06"
+    network_speed = 10
+    hourly_billing = true
+    private_network_only = false
+    cores = 1
+    memory = 1024
+    disks = [25]
+    local_disk = false
+}
+
+resource "ibm_network_interface_sg_attachment" "sg" {
+    security_group_id = %s
+    network_interface_id = "${ibm_compute_vm_instance.sgvm.private_interface_id}"
+}`, hostname, sgID)
+}
@@ -62,6 +62,20 @@ func resourceIBMNetworkPublicIp() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"wait_time_minutes": {
+				Description:  "The number of minutes to wait for the global ip to be provisioned once ordered",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validateIntegerInRange(1, 1440),
+			},
+			"wait_interval_seconds": {
+				Description:  "The minimum number of seconds between two checks of the provisioning of the global ip",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validateIntegerInRange(1, 600),
+			},
 		},
 	}
 }
@@ -81,15 +95,17 @@ func resourceIBMNetworkPublicIpCreate(d *schema.ResourceData, meta interface{})
 
 	log.Println("[INFO] Creating global ip")
 
-	receipt, err := services.GetProductOrderService(sess).
-		PlaceOrder(productOrderContainer, sl.Bool(false))
+	receipt, err := placeOrder(meta, productOrderContainer.PackageId, productOrderContainer)
 	if err != nil {
 		return fmt.Errorf("Error during creation of global ip: %s", err)
 	}
 
-	globalIp, err := findGlobalIpByOrderId(sess, *receipt.OrderId)
+	setPendingOrderID(d, *receipt.OrderId)
+
+	globalIp, err := findGlobalIpByOrderId(sess, *receipt.OrderId, orderWaitTimeout(d), orderWaitInterval(d))
 	if err != nil {
-		return fmt.Errorf("Error during creation of global ip: %s", err)
+		logPendingOrderWait("global ip", *receipt.OrderId, err)
+		return nil
 	}
 
 	d.SetId(fmt.Sprintf("%d", *globalIp.Id))
@@ -102,6 +118,13 @@ func resourceIBMNetworkPublicIpRead(d *schema.ResourceData, meta interface{}) er
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingGlobalIp(d, sess)
+		if err != nil || !provisioned {
+			return err
+		}
+	}
+
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return fmt.Errorf("Not a valid global ip ID, must be an integer: %s", err)
@@ -121,6 +144,10 @@ func resourceIBMNetworkPublicIpRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{}) error {
+	if isPendingOrderID(d.Id()) {
+		return pendingOrderNotProvisionedError("global ip", d.Id())
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
@@ -184,12 +211,26 @@ func resourceIBMNetworkPublicIpDelete(d *schema.ResourceData, meta interface{})
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingGlobalIp(d, sess)
+		if err != nil {
+			return err
+		}
+		if !provisioned {
+			return pendingOrderNotProvisionedError("global ip", d.Id())
+		}
+	}
+
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return fmt.Errorf("Not a valid global ip ID, must be an integer: %s", err)
 	}
 
 	billingItem, err := service.Id(globalIpId).GetBillingItem()
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The global ip %d was already deleted", globalIpId)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("Error deleting global ip: %s", err)
 	}
@@ -204,6 +245,10 @@ func resourceIBMNetworkPublicIpDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIBMNetworkPublicIpExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	if isPendingOrderID(d.Id()) {
+		return true, nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
@@ -222,16 +267,40 @@ func resourceIBMNetworkPublicIpExists(d *schema.ResourceData, meta interface{})
 	return result.Id != nil && *result.Id == globalIpId, nil
 }
 
-func findGlobalIpByOrderId(sess *session.Session, orderId int) (datatypes.Network_Subnet_IpAddress_Global, error) {
+func getGlobalIpsByOrderId(sess *session.Session, orderId int) ([]datatypes.Network_Subnet_IpAddress_Global, error) {
+	return services.GetAccountService(sess).
+		Filter(filter.Path("globalIpRecords.billingItem.orderItem.order.id").
+			Eq(strconv.Itoa(orderId)).Build()).
+		Mask("id,ipAddress[ipAddress]").
+		GetGlobalIpRecords()
+}
+
+// adoptPendingGlobalIp replaces the pending order id of the global ip by the id of the global ip
+// once it is provisioned, and tells whether it is
+func adoptPendingGlobalIp(d *schema.ResourceData, sess *session.Session) (bool, error) {
+	orderId, err := pendingOrderID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	globalIps, err := getGlobalIpsByOrderId(sess, orderId)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving global ip of order %d: %s", orderId, err)
+	}
+	if len(globalIps) == 0 || globalIps[0].IpAddress == nil {
+		log.Printf("[INFO] The global ip of order %d is not provisioned yet", orderId)
+		return false, nil
+	}
+	d.SetId(strconv.Itoa(*globalIps[0].Id))
+	log.Printf("[INFO] Adopted global ip %s of order %d", d.Id(), orderId)
+	return true, nil
+}
+
+func findGlobalIpByOrderId(sess *session.Session, orderId int, timeout, interval time.Duration) (datatypes.Network_Subnet_IpAddress_Global, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
 		Refresh: func() (interface{}, string, error) {
-			globalIps, err := services.GetAccountService(sess).
-				Filter(filter.Path("globalIpRecords.billingItem.orderItem.order.id").
-					Eq(strconv.Itoa(orderId)).Build()).
-				Mask("id,ipAddress[ipAddress]").
-				GetGlobalIpRecords()
+			globalIps, err := getGlobalIpsByOrderId(sess, orderId)
 			if err != nil {
 				return datatypes.Network_Subnet_IpAddress_Global{}, "", err
 			}
@@ -244,9 +313,9 @@ func findGlobalIpByOrderId(sess *session.Session, orderId int) (datatypes.Networ
 				return nil, "", fmt.Errorf("Expected one global ip: %s", err)
 			}
 		},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		MinTimeout: interval,
 	}
 
 	pendingResult, err := stateConf.WaitForState()
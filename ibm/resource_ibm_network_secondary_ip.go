@@ -0,0 +1,214 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const (
+	subnetRouteTypeIpAddress = "SoftLayer_Network_Subnet_IpAddress"
+
+	secondaryIpMask = "id,networkIdentifier,cidr,subnetType,endPointIpAddress[id,ipAddress]"
+)
+
+func resourceIBMNetworkSecondaryIp() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMNetworkSecondaryIpCreate,
+		Read:     resourceIBMNetworkSecondaryIpRead,
+		Update:   resourceIBMNetworkSecondaryIpUpdate,
+		Delete:   resourceIBMNetworkSecondaryIpDelete,
+		Exists:   resourceIBMNetworkSecondaryIpExists,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"routes_to": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					address := v.(string)
+					if net.ParseIP(address) == nil {
+						errors = append(errors, fmt.Errorf("Invalid IP format: %s", address))
+					}
+					return
+				},
+			},
+
+			"subnet": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subnet_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceIBMNetworkSecondaryIpCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+	subnetId := d.Get("subnet_id").(int)
+	routesTo := d.Get("routes_to").(string)
+
+	log.Printf("[INFO] Routing subnet %d to %s", subnetId, routesTo)
+	err := routeSubnet(sess, subnetId, routesTo)
+	if err != nil {
+		return fmt.Errorf("Error routing subnet %d to %s: %s", subnetId, routesTo, err)
+	}
+
+	d.SetId(strconv.Itoa(subnetId))
+
+	return resourceIBMNetworkSecondaryIpRead(d, meta)
+}
+
+func resourceIBMNetworkSecondaryIpRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	subnetId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err)
+	}
+
+	subnet, err := services.GetNetworkSubnetService(sess).Id(subnetId).Mask(secondaryIpMask).GetObject()
+	if err != nil {
+		return fmt.Errorf("Error retrieving subnet %d: %s", subnetId, err)
+	}
+
+	d.Set("subnet_id", *subnet.Id)
+	d.Set("subnet", fmt.Sprintf("%s/%d", *subnet.NetworkIdentifier, *subnet.Cidr))
+	d.Set("subnet_type", sl.Get(subnet.SubnetType, ""))
+	d.Set("routes_to", sl.Grab(subnet, "EndPointIpAddress.IpAddress", ""))
+
+	return nil
+}
+
+func resourceIBMNetworkSecondaryIpUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	subnetId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err)
+	}
+
+	if d.HasChange("routes_to") {
+		routesTo := d.Get("routes_to").(string)
+		log.Printf("[INFO] Routing subnet %d to %s", subnetId, routesTo)
+		err = routeSubnet(sess, subnetId, routesTo)
+		if err != nil {
+			return fmt.Errorf("Error routing subnet %d to %s: %s", subnetId, routesTo, err)
+		}
+	}
+
+	return resourceIBMNetworkSecondaryIpRead(d, meta)
+}
+
+func resourceIBMNetworkSecondaryIpDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	subnetId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err)
+	}
+
+	log.Printf("[INFO] Removing the route of subnet %d", subnetId)
+
+	// The clearRoute method is not available in the vendored SoftLayer client,
+	// so invoke DoRequest directly
+	var success bool
+	err = sess.DoRequest("SoftLayer_Network_Subnet", "clearRoute", nil, &sl.Options{Id: &subnetId}, &success)
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The subnet %d was already deleted", subnetId)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error removing the route of subnet %d: %s", subnetId, err)
+	}
+
+	_, err = waitForSubnetTransactions(sess, subnetId)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the route of subnet %d to be removed: %s", subnetId, err)
+	}
+
+	return nil
+}
+
+func resourceIBMNetworkSecondaryIpExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	subnetId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return false, fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err)
+	}
+
+	subnet, err := services.GetNetworkSubnetService(sess).Id(subnetId).Mask(secondaryIpMask).GetObject()
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error retrieving subnet %d: %s", subnetId, err)
+	}
+
+	// A subnet which is no longer routed was de-routed outside of Terraform
+	return subnet.EndPointIpAddress != nil, nil
+}
+
+// routeSubnet routes the subnet to the IP address routesTo, and waits for the route to be active
+func routeSubnet(sess *session.Session, subnetId int, routesTo string) error {
+	// The route method is not available in the vendored SoftLayer client, so
+	// invoke DoRequest directly
+	var success bool
+	err := sess.DoRequest(
+		"SoftLayer_Network_Subnet",
+		"route",
+		[]interface{}{subnetRouteTypeIpAddress, routesTo},
+		&sl.Options{Id: &subnetId},
+		&success,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForSubnetTransactions(sess, subnetId)
+	return err
+}
+
+// waitForSubnetTransactions waits for the active transactions of a subnet, such as a route
+// update, to complete
+func waitForSubnetTransactions(sess *session.Session, subnetId int) (interface{}, error) {
+	service := services.GetNetworkSubnetService(sess)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"complete"},
+		Refresh: func() (interface{}, string, error) {
+			transaction, err := service.Id(subnetId).GetActiveTransaction()
+			if err != nil {
+				return false, "pending", err
+			}
+			if transaction.Id == nil {
+				return true, "complete", nil
+			}
+			return false, "pending", nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
@@ -0,0 +1,99 @@
+package ibm
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/softlayer/softlayer-go/services"
+)
+
+func TestAccIBMNetworkSecondaryIp_Basic(t *testing.T) {
+	hostname1 := acctest.RandString(16)
+	hostname2 := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMNetworkSecondaryIpDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMNetworkSecondaryIpConfig(hostname1, hostname2, "vm1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_network_secondary_ip.route", "subnet_id", secondarySubnetID),
+					resource.TestCheckResourceAttrPair(
+						"ibm_network_secondary_ip.route", "routes_to",
+						"ibm_compute_vm_instance.vm1", "ipv4_address"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_network_secondary_ip.route", "subnet"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMNetworkSecondaryIpConfig(hostname1, hostname2, "vm2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_network_secondary_ip.route", "routes_to",
+						"ibm_compute_vm_instance.vm2", "ipv4_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMNetworkSecondaryIpDestroy(s *terraform.State) error {
+	service := services.GetNetworkSubnetService(testAccProvider.Meta().(ClientSession).SoftLayerSession())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_network_secondary_ip" {
+			continue
+		}
+
+		subnetId, _ := strconv.Atoi(rs.Primary.ID)
+
+		subnet, err := service.Id(subnetId).Mask(secondaryIpMask).GetObject()
+		if err == nil && subnet.EndPointIpAddress != nil {
+			return fmt.Errorf("Subnet %d is still routed to %s", subnetId, *subnet.EndPointIpAddress.IpAddress)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMNetworkSecondaryIpConfig(hostname1, hostname2, routesTo string) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_vm_instance" "vm1" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "dal06"
+    network_speed = 10
+    hourly_billing = true
+    private_network_only = false
+    cores = 1
+    memory = 1024
+    disks = [25]
+    local_disk = false
+}
+
+resource "ibm_compute_vm_instance" "vm2" {
+    hostname = "%s"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "dal06"
+    network_speed = 10
+    hourly_billing = true
+    private_network_only = false
+    cores = 1
+    memory = 1024
+    disks = [25]
+    local_disk = false
+}
+
+resource "ibm_network_secondary_ip" "route" {
+    subnet_id = %s
+    routes_to = "${ibm_compute_vm_instance.%s.ipv4_address}"
+}`, hostname1, hostname2, secondarySubnetID, routesTo)
+}
@@ -25,17 +25,28 @@ const (
 	AdditionalServicesNetworkVlanPackageType = "ADDITIONAL_SERVICES_NETWORK_VLAN"
 
 	VlanMask = "id,name,primaryRouter[datacenter[name]],primaryRouter[hostname],vlanNumber," +
-		"billingItem[recurringFee],guestNetworkComponentCount,subnets[networkIdentifier,cidr,subnetType],tagReferences[id,tag[name]]"
+		"billingItem[recurringFee],guestNetworkComponentCount," + vlanSubnetsMask + ",tagReferences[id,tag[name]]"
+
+	vlanSubnetsMask = "subnets[networkIdentifier,cidr,subnetType,gateway,broadcastAddress,netmask,usableIpAddressCount]"
+
+	// vlanRefreshMask skips the subnets and tags of the vlan, which are the most expensive to read
+	vlanRefreshMask = "id,name,primaryRouter[datacenter[name]],primaryRouter[hostname],vlanNumber," +
+		"billingItem[id],guestNetworkComponentCount"
+
+	vlanChildrenMask = "id,virtualGuests[id,fullyQualifiedDomainName,billingItem[id]],hardware[id,fullyQualifiedDomainName,billingItem[id]]," +
+		"secondarySubnets[id,networkIdentifier,cidr,billingItem[id]],networkVlanFirewall[id,billingItem[id]]"
 )
 
 func resourceIBMNetworkVlan() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkVlanCreate,
-		Read:     resourceIBMNetworkVlanRead,
-		Update:   resourceIBMNetworkVlanUpdate,
-		Delete:   resourceIBMNetworkVlanDelete,
-		Exists:   resourceIBMNetworkVlanExists,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMNetworkVlanCreate,
+		Read:   resourceIBMNetworkVlanRead,
+		Update: resourceIBMNetworkVlanUpdate,
+		Delete: resourceIBMNetworkVlanDelete,
+		Exists: resourceIBMNetworkVlanExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMNetworkVlanImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -62,8 +73,9 @@ func resourceIBMNetworkVlan() *schema.Resource {
 			},
 			"subnet_size": {
 				Type:     schema.TypeInt,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
+				Default:  0,
 			},
 
 			"name": {
@@ -103,6 +115,22 @@ func resourceIBMNetworkVlan() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"gateway": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"broadcast_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"netmask": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usable_ip_address_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -112,6 +140,49 @@ func resourceIBMNetworkVlan() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"detach_gateway": {
+				Description: "Whether to detach the vlan from its network gateway when it is deleted",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"dry_run_quote": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"wait_time_minutes": {
+				Description:  "The number of minutes to wait for the vlan to be provisioned once ordered",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validateIntegerInRange(1, 1440),
+			},
+			"wait_interval_seconds": {
+				Description:  "The minimum number of seconds between two checks of the provisioning of the vlan",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validateIntegerInRange(1, 600),
+			},
+			"quote_hourly_cost": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"quote_monthly_cost": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"quote_setup_cost": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -137,15 +208,27 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if isDryRunQuote(d, meta) {
+		log.Println("[INFO] Pricing vlan order")
+		return quoteOrder(d, meta, productOrderContainer)
+	}
+
 	log.Println("[INFO] Creating vlan")
 
-	receipt, err := services.GetProductOrderService(sess).
-		PlaceOrder(productOrderContainer, sl.Bool(false))
+	receipt, err := placeOrder(meta, productOrderContainer.PackageId, productOrderContainer)
 	if err != nil {
 		return fmt.Errorf("Error during creation of vlan: %s", err)
 	}
 
-	vlan, err := findVlanByOrderId(sess, *receipt.OrderId)
+	setPendingOrderID(d, *receipt.OrderId)
+
+	vlan, err := findVlanByOrderId(sess, *receipt.OrderId, orderWaitTimeout(d), orderWaitInterval(d))
+	if err != nil {
+		logPendingOrderWait("vlan", *receipt.OrderId, err)
+		return nil
+	}
+
+	d.SetId(fmt.Sprintf("%d", *vlan.Id))
 
 	if len(name) > 0 {
 		_, err = services.GetNetworkVlanService(sess).
@@ -155,8 +238,6 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	d.SetId(fmt.Sprintf("%d", *vlan.Id))
-
 	id := *vlan.Id
 	// Set tags
 	tags := getTags(d)
@@ -171,15 +252,38 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) error {
+	if isQuoteID(d.Id()) {
+		// The vlan is only priced, it gets ordered once dry run is disabled
+		if !isDryRunQuote(d, meta) {
+			d.SetId("")
+		}
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingVlan(d, sess)
+		if err != nil || !provisioned {
+			return err
+		}
+	}
+
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err)
 	}
 
-	vlan, err := service.Id(vlanId).Mask(VlanMask).GetObject()
+	// The subnets and tags are only read once, when the vlan is created or imported, when
+	// skip_detailed_refresh is set on the provider. An imported vlan has no datacenter yet.
+	detailed := !meta.(ClientSession).SkipDetailedRefresh() || d.IsNewResource() || d.Get("datacenter").(string) == ""
+	mask := VlanMask
+	if !detailed {
+		mask = vlanRefreshMask
+	}
+
+	vlan, err := service.Id(vlanId).Mask(mask).GetObject()
 
 	if err != nil {
 		return fmt.Errorf("Error retrieving vlan: %s", err)
@@ -204,37 +308,73 @@ func resourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("softlayer_managed", vlan.BillingItem == nil)
 
+	if !detailed {
+		return nil
+	}
+
 	// Subnets
-	subnets := make([]map[string]interface{}, 0)
+	d.Set("subnets", flattenVlanSubnets(vlan.Subnets))
 
-	for _, elem := range vlan.Subnets {
-		subnet := make(map[string]interface{})
-		subnet["subnet"] = fmt.Sprintf("%s/%s", *elem.NetworkIdentifier, strconv.Itoa(*elem.Cidr))
-		subnet["subnet_type"] = *elem.SubnetType
-		subnets = append(subnets, subnet)
+	d.Set("tags", flattenTagReferences(vlan.TagReferences))
+
+	return nil
+}
+
+// resourceIBMNetworkVlanImportState sets the subnet_size of an imported vlan from its primary subnet.
+// It is not refreshed on read, since portable subnets can be added to the vlan later.
+func resourceIBMNetworkVlanImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	vlanId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err)
 	}
-	d.Set("subnets", subnets)
 
-	if vlan.Subnets != nil && len(vlan.Subnets) > 0 {
-		d.Set("subnet_size", 1<<(uint)(32-*vlan.Subnets[0].Cidr))
-	} else {
-		d.Set("subnet_size", 0)
+	vlan, err := services.GetNetworkVlanService(sess).Id(vlanId).Mask("id," + vlanSubnetsMask).GetObject()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving vlan: %s", err)
 	}
 
-	tagRefs := vlan.TagReferences
-	tagRefsLen := len(tagRefs)
-	if tagRefsLen > 0 {
-		tags := make([]string, tagRefsLen, tagRefsLen)
-		for i, tagRef := range tagRefs {
-			tags[i] = *tagRef.Tag.Name
+	d.Set("subnet_size", vlanPrimarySubnetSize(vlan.Subnets))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// vlanPrimarySubnetSize returns the size of the primary subnet ordered with the vlan, or 0 when the
+// vlan was ordered without a subnet
+func vlanPrimarySubnetSize(vlanSubnets []datatypes.Network_Subnet) int {
+	for _, subnet := range vlanSubnets {
+		if sl.Get(subnet.SubnetType, "").(string) == "PRIMARY" && subnet.Cidr != nil {
+			return 1 << (uint)(32-*subnet.Cidr)
 		}
-		d.Set("tags", tags)
 	}
+	return 0
+}
 
-	return nil
+func flattenVlanSubnets(vlanSubnets []datatypes.Network_Subnet) []map[string]interface{} {
+	subnets := make([]map[string]interface{}, 0)
+
+	for _, elem := range vlanSubnets {
+		subnet := make(map[string]interface{})
+		subnet["subnet"] = fmt.Sprintf("%s/%s", *elem.NetworkIdentifier, strconv.Itoa(*elem.Cidr))
+		subnet["subnet_type"] = *elem.SubnetType
+		subnet["gateway"] = sl.Get(elem.Gateway, "")
+		subnet["broadcast_address"] = sl.Get(elem.BroadcastAddress, "")
+		subnet["netmask"] = sl.Get(elem.Netmask, "")
+		subnet["usable_ip_address_count"] = int(sl.Get(elem.UsableIpAddressCount, datatypes.Float64(0)).(datatypes.Float64))
+		subnets = append(subnets, subnet)
+	}
+	return subnets
 }
 
 func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	if isQuoteID(d.Id()) {
+		return nil
+	}
+	if isPendingOrderID(d.Id()) {
+		return pendingOrderNotProvisionedError("vlan", d.Id())
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
@@ -273,15 +413,33 @@ func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) error {
+	if isQuoteID(d.Id()) {
+		return nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingVlan(d, sess)
+		if err != nil {
+			return err
+		}
+		if !provisioned {
+			return pendingOrderNotProvisionedError("vlan", d.Id())
+		}
+	}
+
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err)
 	}
 
 	billingItem, err := service.Id(vlanId).GetBillingItem()
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The vlan %d was already deleted", vlanId)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("Error deleting vlan: %s", err)
 	}
@@ -294,6 +452,38 @@ func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 
+	// All the preconditions are checked before anything is changed, so that a refused destroy
+	// leaves the VLAN as it is.
+
+	// A VLAN can't be cancelled while it is associated with a network gateway. Unless
+	// detach_gateway is set, refuse to cancel it and name the gateway.
+	gatewayVlan, err := getVlanGatewayAttachment(sess, vlanId, d.Get("detach_gateway").(bool))
+	if err != nil {
+		return fmt.Errorf("Error deleting vlan: %s", err)
+	}
+
+	// The physical VLAN is only deleted once it has no child resources left. Unless force_delete
+	// is set, refuse to cancel a VLAN with child resources, as it would be left behind.
+	children, err := getVlanChildren(sess, vlanId)
+	if err != nil {
+		return fmt.Errorf("Error deleting vlan: %s", err)
+	}
+	err = checkVlanChildren(vlanId, children, d.Get("force_delete").(bool))
+	if err != nil {
+		return fmt.Errorf("Error deleting vlan: %s", err)
+	}
+
+	if gatewayVlan != nil {
+		err = detachVlanGateway(sess, vlanId, *gatewayVlan)
+		if err != nil {
+			return fmt.Errorf("Error deleting vlan: %s", err)
+		}
+	}
+	err = cancelVlanChildren(sess, vlanId, children)
+	if err != nil {
+		return fmt.Errorf("Error deleting vlan: %s", err)
+	}
+
 	// If the VLAN has a billing item, the function deletes the billing item and returns so that
 	// the VLAN resource in a terraform state file can be deleted. Physical VLAN will be deleted
 	// automatically which the VLAN doesn't have any child resources.
@@ -302,7 +492,162 @@ func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) erro
 	return err
 }
 
+// vlanChild is a resource attached to a vlan which prevents the physical vlan from being deleted
+type vlanChild struct {
+	description   string
+	billingItemId *int
+}
+
+func getVlanChildren(sess *session.Session, vlanId int) ([]vlanChild, error) {
+	vlan, err := services.GetNetworkVlanService(sess).Id(vlanId).Mask(vlanChildrenMask).GetObject()
+	if err != nil {
+		return nil, err
+	}
+	return flattenVlanChildren(vlan), nil
+}
+
+// flattenVlanChildren returns the guests, hardware, secondary subnets and firewall of the vlan
+func flattenVlanChildren(vlan datatypes.Network_Vlan) []vlanChild {
+	children := []vlanChild{}
+	for _, guest := range vlan.VirtualGuests {
+		child := vlanChild{description: fmt.Sprintf("virtual guest %s", sl.Get(guest.FullyQualifiedDomainName, strconv.Itoa(*guest.Id)))}
+		if guest.BillingItem != nil {
+			child.billingItemId = guest.BillingItem.Id
+		}
+		children = append(children, child)
+	}
+	for _, hw := range vlan.Hardware {
+		child := vlanChild{description: fmt.Sprintf("hardware %s", sl.Get(hw.FullyQualifiedDomainName, strconv.Itoa(*hw.Id)))}
+		if hw.BillingItem != nil {
+			child.billingItemId = hw.BillingItem.Id
+		}
+		children = append(children, child)
+	}
+	for _, subnet := range vlan.SecondarySubnets {
+		child := vlanChild{description: fmt.Sprintf("subnet %s/%d", sl.Get(subnet.NetworkIdentifier, ""), sl.Get(subnet.Cidr, 0))}
+		if subnet.BillingItem != nil {
+			child.billingItemId = subnet.BillingItem.Id
+		}
+		children = append(children, child)
+	}
+	if vlan.NetworkVlanFirewall != nil {
+		child := vlanChild{description: fmt.Sprintf("firewall %d", sl.Get(vlan.NetworkVlanFirewall.Id, 0))}
+		if vlan.NetworkVlanFirewall.BillingItem != nil {
+			child.billingItemId = vlan.NetworkVlanFirewall.BillingItem.Id
+		}
+		children = append(children, child)
+	}
+	return children
+}
+
+// checkVlanChildren fails if the vlan still has child resources and force is not set, or if a child
+// resource can't be cancelled when force is set
+func checkVlanChildren(vlanId int, children []vlanChild, force bool) error {
+	if len(children) == 0 {
+		return nil
+	}
+
+	if !force {
+		descriptions := make([]string, len(children))
+		for i, child := range children {
+			descriptions[i] = child.description
+		}
+		return fmt.Errorf("vlan %d still has child resources (%s). Delete them first, or set force_delete to cancel them along with the vlan",
+			vlanId, strings.Join(descriptions, ", "))
+	}
+
+	for _, child := range children {
+		if child.billingItemId == nil {
+			return fmt.Errorf("%s of vlan %d has no billing item and can't be cancelled", child.description, vlanId)
+		}
+	}
+	return nil
+}
+
+// cancelVlanChildren cancels the billing items of the child resources of the vlan, once they were
+// checked by checkVlanChildren
+func cancelVlanChildren(sess *session.Session, vlanId int, children []vlanChild) error {
+	billingService := services.GetBillingItemService(sess)
+	for _, child := range children {
+		log.Printf("[INFO] Cancelling %s of vlan %d", child.description, vlanId)
+		_, err := billingService.Id(*child.billingItemId).CancelService()
+		if err != nil {
+			return fmt.Errorf("Error cancelling %s: %s", child.description, err)
+		}
+	}
+
+	return nil
+}
+
+// getVlanGatewayAttachment returns the association of the VLAN with the network gateway it is
+// inside of, nil if there is none. It fails if the VLAN connects a gateway to the networks, or if
+// it is associated with a gateway and detach is not set.
+func getVlanGatewayAttachment(sess *session.Session, vlanId int, detach bool) (*datatypes.Network_Gateway_Vlan, error) {
+	vlan, err := services.GetNetworkVlanService(sess).Id(vlanId).Mask(
+		"id,attachedNetworkGatewayVlan[id,bypassFlag,networkGateway[id,name]]," +
+			"publicNetworkGateways[id,name],privateNetworkGateways[id,name]").GetObject()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the network gateways of vlan %d: %s", vlanId, err)
+	}
+
+	// The VLANs connecting a gateway to the networks can only be released with the gateway
+	if uplinks := vlanGatewayUplinks(vlan); len(uplinks) > 0 {
+		return nil, fmt.Errorf("vlan %d is the %s. Delete the network gateway first", vlanId, strings.Join(uplinks, ", "))
+	}
+
+	gatewayVlan := vlan.AttachedNetworkGatewayVlan
+	if gatewayVlan == nil || gatewayVlan.Id == nil {
+		return nil, nil
+	}
+	if !detach {
+		return nil, fmt.Errorf("vlan %d is associated with %s. Detach it first, or set detach_gateway to detach it along with the vlan",
+			vlanId, describeGatewayVlan(*gatewayVlan))
+	}
+	return gatewayVlan, nil
+}
+
+// detachVlanGateway detaches the VLAN from the network gateway it is inside of. The VLAN is
+// bypassed before it is detached, so its traffic is not interrupted.
+func detachVlanGateway(sess *session.Session, vlanId int, gatewayVlan datatypes.Network_Gateway_Vlan) error {
+	gateway := describeGatewayVlan(gatewayVlan)
+	if !sl.Get(gatewayVlan.BypassFlag, false).(bool) {
+		err := setGatewayVlanBypass(sess, *gatewayVlan.Id, true)
+		if err != nil {
+			return err
+		}
+	}
+	log.Printf("[INFO] Detaching vlan %d from %s", vlanId, gateway)
+	err := services.GetNetworkGatewayVlanService(sess).Id(*gatewayVlan.Id).DeleteObject()
+	if err != nil && !isSoftLayerNotFound(err) {
+		return fmt.Errorf("Error detaching vlan %d from %s: %s", vlanId, gateway, err)
+	}
+	return nil
+}
+
+func describeGatewayVlan(gatewayVlan datatypes.Network_Gateway_Vlan) string {
+	return fmt.Sprintf("network gateway %s (%d)",
+		sl.Grab(gatewayVlan, "NetworkGateway.Name", ""), sl.Grab(gatewayVlan, "NetworkGateway.Id", 0))
+}
+
+// vlanGatewayUplinks describes the network gateways which the VLAN is the public or private VLAN of
+func vlanGatewayUplinks(vlan datatypes.Network_Vlan) []string {
+	uplinks := make([]string, 0)
+	for _, gateway := range vlan.PublicNetworkGateways {
+		uplinks = append(uplinks, fmt.Sprintf("public vlan of network gateway %s (%d)",
+			sl.Get(gateway.Name, ""), sl.Get(gateway.Id, 0)))
+	}
+	for _, gateway := range vlan.PrivateNetworkGateways {
+		uplinks = append(uplinks, fmt.Sprintf("private vlan of network gateway %s (%d)",
+			sl.Get(gateway.Name, ""), sl.Get(gateway.Id, 0)))
+	}
+	return uplinks
+}
+
 func resourceIBMNetworkVlanExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	if isQuoteID(d.Id()) || isPendingOrderID(d.Id()) {
+		return true, nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
@@ -323,16 +668,12 @@ func resourceIBMNetworkVlanExists(d *schema.ResourceData, meta interface{}) (boo
 	return result.Id != nil && *result.Id == vlanID, nil
 }
 
-func findVlanByOrderId(sess *session.Session, orderId int) (datatypes.Network_Vlan, error) {
+func findVlanByOrderId(sess *session.Session, orderId int, timeout, interval time.Duration) (datatypes.Network_Vlan, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
 		Refresh: func() (interface{}, string, error) {
-			vlans, err := services.GetAccountService(sess).
-				Filter(filter.Path("networkVlans.billingItem.orderItem.order.id").
-					Eq(strconv.Itoa(orderId)).Build()).
-				Mask("id").
-				GetNetworkVlans()
+			vlans, err := getVlansByOrderId(sess, orderId)
 			if err != nil {
 				return datatypes.Network_Vlan{}, "", err
 			}
@@ -345,9 +686,9 @@ func findVlanByOrderId(sess *session.Session, orderId int) (datatypes.Network_Vl
 				return nil, "", fmt.Errorf("Expected one vlan: %s", err)
 			}
 		},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		MinTimeout: interval,
 	}
 
 	pendingResult, err := stateConf.WaitForState()
@@ -366,6 +707,33 @@ func findVlanByOrderId(sess *session.Session, orderId int) (datatypes.Network_Vl
 		fmt.Errorf("Cannot find vlan with order id '%d'", orderId)
 }
 
+func getVlansByOrderId(sess *session.Session, orderId int) ([]datatypes.Network_Vlan, error) {
+	return getAccountNetworkVlans(services.GetAccountService(sess).
+		Filter(filter.Path("networkVlans.billingItem.orderItem.order.id").
+			Eq(strconv.Itoa(orderId)).Build()).
+		Mask("id"))
+}
+
+// adoptPendingVlan replaces the pending order id of the vlan by the id of the vlan once it is
+// provisioned, and tells whether it is
+func adoptPendingVlan(d *schema.ResourceData, sess *session.Session) (bool, error) {
+	orderId, err := pendingOrderID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	vlans, err := getVlansByOrderId(sess, orderId)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving vlan of order %d: %s", orderId, err)
+	}
+	if len(vlans) == 0 {
+		log.Printf("[INFO] The vlan of order %d is not provisioned yet", orderId)
+		return false, nil
+	}
+	d.SetId(strconv.Itoa(*vlans[0].Id))
+	log.Printf("[INFO] Adopted vlan %s of order %d", d.Id(), orderId)
+	return true, nil
+}
+
 func buildVlanProductOrderContainer(d *schema.ResourceData, sess *session.Session, packageType string) (
 	*datatypes.Container_Product_Order_Network_Vlan, error) {
 	var rt datatypes.Hardware
@@ -396,9 +764,10 @@ func buildVlanProductOrderContainer(d *schema.ResourceData, sess *session.Sessio
 		return &datatypes.Container_Product_Order_Network_Vlan{}, err
 	}
 
-	// 3. Find vlan and subnet prices
+	// 3. Find vlan and subnet prices. No subnet is ordered when subnet_size is 0.
 	vlanKeyname := vlanType + "_NETWORK_VLAN"
-	subnetKeyname := strconv.Itoa(d.Get("subnet_size").(int)) + "_STATIC_PUBLIC_IP_ADDRESSES"
+	subnetSize := d.Get("subnet_size").(int)
+	subnetKeyname := strconv.Itoa(subnetSize) + "_STATIC_PUBLIC_IP_ADDRESSES"
 
 	// 4. Select items with a matching keyname
 	vlanItems := []datatypes.Product_Item{}
@@ -407,7 +776,7 @@ func buildVlanProductOrderContainer(d *schema.ResourceData, sess *session.Sessio
 		if *item.KeyName == vlanKeyname {
 			vlanItems = append(vlanItems, item)
 		}
-		if strings.Contains(*item.KeyName, subnetKeyname) {
+		if subnetSize > 0 && strings.Contains(*item.KeyName, subnetKeyname) {
 			subnetItems = append(subnetItems, item)
 		}
 	}
@@ -417,7 +786,7 @@ func buildVlanProductOrderContainer(d *schema.ResourceData, sess *session.Sessio
 			fmt.Errorf("No product items matching %s could be found", vlanKeyname)
 	}
 
-	if len(subnetItems) == 0 {
+	if subnetSize > 0 && len(subnetItems) == 0 {
 		return &datatypes.Container_Product_Order_Network_Vlan{},
 			fmt.Errorf("No product items matching %s could be found", subnetKeyname)
 	}
@@ -430,13 +799,14 @@ func buildVlanProductOrderContainer(d *schema.ResourceData, sess *session.Sessio
 				{
 					Id: vlanItems[0].Prices[0].Id,
 				},
-				{
-					Id: subnetItems[0].Prices[0].Id,
-				},
 			},
 			Quantity: sl.Int(1),
 		},
 	}
+	if subnetSize > 0 {
+		productOrderContainer.Prices = append(productOrderContainer.Prices,
+			datatypes.Product_Item_Price{Id: subnetItems[0].Prices[0].Id})
+	}
 
 	if len(router) > 0 {
 		rt, err = hardware.GetRouterByName(sess, router, "id")
@@ -2,9 +2,14 @@ package ibm
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/sl"
 )
 
 func TestAccIBMNetworkVlan_Basic(t *testing.T) {
@@ -87,6 +92,78 @@ func TestAccIBMNetworkVlan_With_Tag(t *testing.T) {
 					),
 				),
 			},
+
+			resource.TestStep{
+				Config: testAccCheckIBMNetworkVlanConfig_without_tags,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_network_vlan.test_vlan", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMNetworkVlan_DryRunQuote(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMNetworkVlanConfig_dry_run_quote,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"ibm_network_vlan.test_vlan", "id", regexp.MustCompile("^quote:")),
+					resource.TestCheckResourceAttrSet(
+						"ibm_network_vlan.test_vlan", "quote_monthly_cost"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_network_vlan.test_vlan", "quote_hourly_cost"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMNetworkVlan_WithoutSubnet(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMNetworkVlanConfig_without_subnet,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_network_vlan.test_vlan", "type", "PRIVATE"),
+					resource.TestCheckResourceAttr(
+						"ibm_network_vlan.test_vlan", "subnet_size", "0"),
+				),
+			},
+
+			resource.TestStep{
+				ResourceName:            "ibm_network_vlan.test_vlan",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete", "detach_gateway", "dry_run_quote", "wait_time_minutes", "wait_interval_seconds"},
+			},
+		},
+	})
+}
+
+func TestAccIBMNetworkVlan_ForceDelete(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMNetworkVlanConfig_force_delete,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_network_vlan.test_vlan", "force_delete", "true"),
+				),
+			},
 		},
 	})
 }
@@ -109,6 +186,34 @@ resource "ibm_network_vlan" "test_vlan" {
    router_hostname = "fcr01a.lon02"
 }`
 
+const testAccCheckIBMNetworkVlanConfig_dry_run_quote = `
+resource "ibm_network_vlan" "test_vlan" {
+   name = "test_vlan"
+   datacenter = "lon02"
+   type = "PUBLIC"
+   subnet_size = 8
+   router_hostname = "fcr01a.lon02"
+   dry_run_quote = true
+}`
+
+const testAccCheckIBMNetworkVlanConfig_without_subnet = `
+resource "ibm_network_vlan" "test_vlan" {
+   name = "test_vlan"
+   datacenter = "lon02"
+   type = "PRIVATE"
+   router_hostname = "bcr01a.lon02"
+}`
+
+const testAccCheckIBMNetworkVlanConfig_force_delete = `
+resource "ibm_network_vlan" "test_vlan" {
+   name = "test_vlan"
+   datacenter = "lon02"
+   type = "PUBLIC"
+   subnet_size = 8
+   router_hostname = "fcr01a.lon02"
+   force_delete = true
+}`
+
 func testAccCheckIBMNetworkVlanConfigWithTag(tag1 string) string {
 	return fmt.Sprintf(`
 		resource "ibm_network_vlan" "test_vlan" {
@@ -133,3 +238,158 @@ func testAccCheckIBMNetworkVlanConfigTagUpdate(tag1, tag2 string) string {
 	 }`, tag1, tag2)
 
 }
+
+const testAccCheckIBMNetworkVlanConfig_without_tags = `
+resource "ibm_network_vlan" "test_vlan" {
+	name = "test_vlan"
+	datacenter = "lon02"
+	type = "PUBLIC"
+	subnet_size = 8
+	router_hostname = "fcr01a.lon02"
+}`
+
+func TestFlattenVlanSubnets(t *testing.T) {
+	usable := datatypes.Float64(5)
+	subnets := flattenVlanSubnets([]datatypes.Network_Subnet{
+		{
+			NetworkIdentifier:    sl.String("10.0.0.0"),
+			Cidr:                 sl.Int(29),
+			SubnetType:           sl.String("PRIMARY"),
+			Gateway:              sl.String("10.0.0.1"),
+			BroadcastAddress:     sl.String("10.0.0.7"),
+			Netmask:              sl.String("255.255.255.248"),
+			UsableIpAddressCount: &usable,
+		},
+	})
+
+	if len(subnets) != 1 {
+		t.Fatalf("Expected 1 subnet, got %d", len(subnets))
+	}
+	expected := map[string]interface{}{
+		"subnet":                  "10.0.0.0/29",
+		"subnet_type":             "PRIMARY",
+		"gateway":                 "10.0.0.1",
+		"broadcast_address":       "10.0.0.7",
+		"netmask":                 "255.255.255.248",
+		"usable_ip_address_count": 5,
+	}
+	for k, v := range expected {
+		if subnets[0][k] != v {
+			t.Errorf("Expected %s to be %v, got %v", k, v, subnets[0][k])
+		}
+	}
+}
+
+func TestVlanPrimarySubnetSize(t *testing.T) {
+	primary := datatypes.Network_Subnet{SubnetType: sl.String("PRIMARY"), Cidr: sl.Int(29)}
+	portable := datatypes.Network_Subnet{SubnetType: sl.String("SECONDARY_ON_VLAN"), Cidr: sl.Int(28)}
+
+	testCases := []struct {
+		subnets []datatypes.Network_Subnet
+		size    int
+	}{
+		{[]datatypes.Network_Subnet{primary}, 8},
+		{[]datatypes.Network_Subnet{portable, primary}, 8},
+		{[]datatypes.Network_Subnet{portable}, 0},
+		{nil, 0},
+	}
+
+	for _, tc := range testCases {
+		if size := vlanPrimarySubnetSize(tc.subnets); size != tc.size {
+			t.Errorf("Expected subnet size %d, got %d", tc.size, size)
+		}
+	}
+}
+
+func TestFlattenTagReferences(t *testing.T) {
+	refs := []datatypes.Tag_Reference{
+		{Tag: &datatypes.Tag{Name: sl.String("collectd")}},
+		{Tag: &datatypes.Tag{Name: sl.String("mesos-master")}},
+	}
+	if tags := flattenTagReferences(refs); !reflect.DeepEqual(tags, []string{"collectd", "mesos-master"}) {
+		t.Errorf("Expected the names of the tags, got %v", tags)
+	}
+	if tags := flattenTagReferences(nil); tags == nil || len(tags) != 0 {
+		t.Errorf("Expected an empty list of tags, got %v", tags)
+	}
+}
+
+func TestVlanGatewayUplinks(t *testing.T) {
+	vlan := datatypes.Network_Vlan{
+		PublicNetworkGateways:  []datatypes.Network_Gateway{{Id: sl.Int(12), Name: sl.String("gw1")}},
+		PrivateNetworkGateways: []datatypes.Network_Gateway{{Id: sl.Int(13), Name: sl.String("gw2")}},
+	}
+	expected := []string{"public vlan of network gateway gw1 (12)", "private vlan of network gateway gw2 (13)"}
+	if uplinks := vlanGatewayUplinks(vlan); !reflect.DeepEqual(uplinks, expected) {
+		t.Errorf("Expected %v, got %v", expected, uplinks)
+	}
+	if uplinks := vlanGatewayUplinks(datatypes.Network_Vlan{}); len(uplinks) != 0 {
+		t.Errorf("Expected no uplinks, got %v", uplinks)
+	}
+}
+
+func TestFlattenVlanChildren(t *testing.T) {
+	vlan := datatypes.Network_Vlan{
+		VirtualGuests: []datatypes.Virtual_Guest{
+			{Id: sl.Int(1), FullyQualifiedDomainName: sl.String("vm1.example.com"), BillingItem: &datatypes.Billing_Item_Virtual_Guest{Billing_Item: datatypes.Billing_Item{Id: sl.Int(11)}}},
+		},
+		Hardware: []datatypes.Hardware{
+			{Id: sl.Int(2)},
+		},
+		SecondarySubnets: []datatypes.Network_Subnet{
+			{Id: sl.Int(3), NetworkIdentifier: sl.String("10.0.0.0"), Cidr: sl.Int(29), BillingItem: &datatypes.Billing_Item{Id: sl.Int(13)}},
+		},
+	}
+
+	children := flattenVlanChildren(vlan)
+	expected := []vlanChild{
+		{description: "virtual guest vm1.example.com", billingItemId: sl.Int(11)},
+		{description: "hardware 2"},
+		{description: "subnet 10.0.0.0/29", billingItemId: sl.Int(13)},
+	}
+	if !reflect.DeepEqual(children, expected) {
+		t.Errorf("Expected %v, got %v", expected, children)
+	}
+}
+
+func TestCheckVlanChildren(t *testing.T) {
+	billed := []vlanChild{
+		{description: "virtual guest vm1.example.com", billingItemId: sl.Int(11)},
+		{description: "subnet 10.0.0.0/29", billingItemId: sl.Int(13)},
+	}
+
+	if err := checkVlanChildren(42, nil, false); err != nil {
+		t.Errorf("Expected a vlan without child resources to be deleted, got %s", err)
+	}
+
+	// Without force_delete, the destroy fails and names the child resources
+	err := checkVlanChildren(42, billed, false)
+	if err == nil {
+		t.Fatal("Expected a vlan with child resources not to be deleted without force_delete")
+	}
+	for _, s := range []string{"vlan 42", "virtual guest vm1.example.com", "subnet 10.0.0.0/29", "force_delete"} {
+		if !strings.Contains(err.Error(), s) {
+			t.Errorf("Expected the error to contain %q, got %s", s, err)
+		}
+	}
+
+	// With force_delete, the child resources are cancelled when they all have a billing item
+	if err := checkVlanChildren(42, billed, true); err != nil {
+		t.Errorf("Expected the child resources to be cancelled with force_delete, got %s", err)
+	}
+	unbilled := append(billed, vlanChild{description: "hardware 2"})
+	err = checkVlanChildren(42, unbilled, true)
+	if err == nil || !strings.Contains(err.Error(), "hardware 2 of vlan 42 has no billing item") {
+		t.Errorf("Expected an error for a child resource without billing item, got %v", err)
+	}
+}
+
+func TestDescribeGatewayVlan(t *testing.T) {
+	gatewayVlan := datatypes.Network_Gateway_Vlan{
+		Id:             sl.Int(7),
+		NetworkGateway: &datatypes.Network_Gateway{Id: sl.Int(12), Name: sl.String("gw1")},
+	}
+	if gateway := describeGatewayVlan(gatewayVlan); gateway != "network gateway gw1 (12)" {
+		t.Errorf("Expected network gateway gw1 (12), got %s", gateway)
+	}
+}
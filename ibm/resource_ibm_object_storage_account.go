@@ -56,15 +56,13 @@ func resourceIBMObjectStorageAccountCreate(d *schema.ResourceData, meta interfac
 
 	if len(objectStorageAccounts) == 0 {
 		// Order the account
-		productOrderService := services.GetProductOrderService(sess)
-
-		receipt, err := productOrderService.PlaceOrder(&datatypes.Container_Product_Order{
+		receipt, err := placeOrder(meta, sl.Int(0), &datatypes.Container_Product_Order{
 			Quantity:  sl.Int(1),
 			PackageId: sl.Int(0),
 			Prices: []datatypes.Product_Item_Price{
 				{Id: sl.Int(30920)},
 			},
-		}, sl.Bool(false))
+		})
 		if err != nil {
 			return fmt.Errorf(
 				"resource_ibm_object_storage_account: Error ordering account: %s", err)
@@ -2,13 +2,24 @@ package ibm
 
 import (
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/IBM-Bluemix/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Bluemix/bluemix-go/bmxerror"
 	"github.com/IBM-Bluemix/bluemix-go/helpers"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// The states of the last operation of a service instance, reported by asynchronous service brokers
+const (
+	serviceInstanceSucceeded  = "succeeded"
+	serviceInstanceFailed     = "failed"
+	serviceInstanceInProgress = "in progress"
+	serviceInstanceDeleted    = "deleted"
+)
+
 func resourceIBMServiceInstance() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMServiceInstanceCreate,
@@ -91,6 +102,31 @@ func resourceIBMServiceInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"wait_time_minutes": {
+				Description: "Define timeout to wait for the service broker to complete the creation, update or deletion of the service instance",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+			},
+
+			"last_operation_type": {
+				Description: "The type of the last operation of the service instance, such as create or update",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"last_operation_state": {
+				Description: "The state of the last operation of the service instance, such as in progress or succeeded",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"last_operation_description": {
+				Description: "The description of the last operation of the service instance given by the service broker",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -136,6 +172,11 @@ func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(service.Metadata.GUID)
 
+	_, err = waitForServiceInstanceAvailable(d, meta)
+	if err != nil {
+		return fmt.Errorf("Error waiting for create service instance (%s) to complete: %s", d.Id(), err)
+	}
+
 	return resourceIBMServiceInstanceRead(d, meta)
 }
 
@@ -160,6 +201,9 @@ func resourceIBMServiceInstanceRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("credentials", flattenCredentials(service.Entity.Credentials))
 	d.Set("tags", service.Entity.Tags)
 	d.Set("name", service.Entity.Name)
+	d.Set("last_operation_type", service.Entity.LastOperation.Type)
+	d.Set("last_operation_state", service.Entity.LastOperation.State)
+	d.Set("last_operation_description", service.Entity.LastOperation.Description)
 
 	d.Set("plan", service.Entity.ServicePlan.Entity.Name)
 
@@ -210,9 +254,16 @@ func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		updateReq.Tags = &tags
 	}
 
-	_, err = cfClient.ServiceInstances().Update(serviceGUID, updateReq)
-	if err != nil {
-		return fmt.Errorf("Error updating service: %s", err)
+	if d.HasChange("name") || d.HasChange("plan") || d.HasChange("parameters") || d.HasChange("tags") {
+		_, err = cfClient.ServiceInstances().Update(serviceGUID, updateReq)
+		if err != nil {
+			return fmt.Errorf("Error updating service: %s", err)
+		}
+
+		_, err = waitForServiceInstanceAvailable(d, meta)
+		if err != nil {
+			return fmt.Errorf("Error waiting for update service instance (%s) to complete: %s", d.Id(), err)
+		}
 	}
 
 	return resourceIBMServiceInstanceRead(d, meta)
@@ -230,6 +281,11 @@ func resourceIBMServiceInstanceDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error deleting service: %s", err)
 	}
 
+	_, err = waitForServiceInstanceDelete(d, meta)
+	if err != nil {
+		return fmt.Errorf("Error waiting for delete service instance (%s) to complete: %s", d.Id(), err)
+	}
+
 	d.SetId("")
 
 	return nil
@@ -254,6 +310,69 @@ func resourceIBMServiceInstanceExists(d *schema.ResourceData, meta interface{})
 	return service.Metadata.GUID == serviceGUID, nil
 }
 
+// waitForServiceInstanceAvailable waits for the last operation of the service instance to succeed,
+// since asynchronous service brokers complete the operations after the request is accepted
+func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	cfClient, err := meta.(ClientSession).MccpAPI()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Waiting for service instance (%s) to be available.", d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{serviceInstanceInProgress},
+		Target:     []string{serviceInstanceSucceeded},
+		Refresh:    serviceInstanceStateRefreshFunc(cfClient.ServiceInstances(), d.Id()),
+		Timeout:    time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	cfClient, err := meta.(ClientSession).MccpAPI()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Waiting for service instance (%s) to be deleted.", d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{serviceInstanceInProgress, serviceInstanceSucceeded},
+		Target:     []string{serviceInstanceDeleted},
+		Refresh:    serviceInstanceStateRefreshFunc(cfClient.ServiceInstances(), d.Id()),
+		Timeout:    time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func serviceInstanceStateRefreshFunc(client mccpv2.ServiceInstances, instanceGUID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		service, err := client.Get(instanceGUID)
+		if err != nil {
+			if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				return instanceGUID, serviceInstanceDeleted, nil
+			}
+			return nil, "", fmt.Errorf("Error retrieving service instance: %s", err)
+		}
+
+		lastOperation := service.Entity.LastOperation
+		switch lastOperation.State {
+		case serviceInstanceFailed:
+			return service, lastOperation.State, fmt.Errorf("The %s operation of the service instance failed: %s",
+				lastOperation.Type, lastOperation.Description)
+		case serviceInstanceInProgress:
+			return service, lastOperation.State, nil
+		}
+		// Synchronous service brokers may not report the last operation
+		return service, serviceInstanceSucceeded, nil
+	}
+}
+
 func getServiceTags(d *schema.ResourceData) []string {
 	tagSet := d.Get("tags").(*schema.Set)
 
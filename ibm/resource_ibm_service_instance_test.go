@@ -31,6 +31,7 @@ func TestAccIBMServiceInstance_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_service_instance.service", "service", "cleardb"),
 					resource.TestCheckResourceAttr("ibm_service_instance.service", "plan", "cb5"),
 					resource.TestCheckResourceAttr("ibm_service_instance.service", "tags.#", "2"),
+					resource.TestCheckResourceAttr("ibm_service_instance.service", "last_operation_state", "succeeded"),
 				),
 			},
 			resource.TestStep{
@@ -86,9 +87,10 @@ func TestAccIBMServiceInstance_import(t *testing.T) {
 				),
 			},
 			resource.TestStep{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_time_minutes"},
 			},
 		},
 	})
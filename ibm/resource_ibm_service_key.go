@@ -1,6 +1,7 @@
 package ibm
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/IBM-Bluemix/bluemix-go/bmxerror"
@@ -31,10 +32,25 @@ func resourceIBMServiceKey() *schema.Resource {
 				Description: "The guid of the service instance for which to create service key",
 			},
 			"parameters": {
-				Type:        schema.TypeMap,
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Arbitrary parameters to pass along to the service broker. Must be a JSON object",
+				ConflictsWith: []string{"parameters_json"},
+			},
+			"parameters_json": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Arbitrary parameters to pass along to the service broker, as a JSON object which can have nested values",
+				ValidateFunc:  validateJSONObject,
+				ConflictsWith: []string{"parameters"},
+			},
+			"role": {
+				Type:        schema.TypeString,
 				Optional:    true,
 				ForceNew:    true,
-				Description: "Arbitrary parameters to pass along to the service broker. Must be a JSON object",
+				Description: "The IAM role of the credentials of the key, such as Reader, Writer or Manager, for the IAM enabled services",
 			},
 			"credentials": {
 				Description: "Credentials asociated with the key",
@@ -42,6 +58,45 @@ func resourceIBMServiceKey() *schema.Resource {
 				Sensitive:   true,
 				Computed:    true,
 			},
+			"iam_role_crn": {
+				Description: "The CRN of the IAM role of the credentials",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"iam_apikey": {
+				Description: "The IAM API key of the credentials",
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+			},
+			"url": {
+				Description: "The URL of the service, such as the URL of a Cloudant account",
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+			},
+			"username": {
+				Description: "The username of the credentials",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"password": {
+				Description: "The password of the credentials",
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+			},
+			"cos_hmac_access_key_id": {
+				Description: "The access key ID of the HMAC credentials of a Cloud Object Storage key",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"cos_hmac_secret_access_key": {
+				Description: "The secret access key of the HMAC credentials of a Cloud Object Storage key",
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+			},
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -59,10 +114,9 @@ func resourceIBMServiceKeyCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	name := d.Get("name").(string)
 	serviceInstanceGUID := d.Get("service_instance_guid").(string)
-	var parameters map[string]interface{}
-
-	if parameters, ok := d.GetOk("parameters"); ok {
-		parameters = parameters.(map[string]interface{})
+	parameters, err := expandServiceKeyParameters(d)
+	if err != nil {
+		return err
 	}
 
 	serviceKey, err := cfClient.ServiceKeys().Create(serviceInstanceGUID, name, parameters)
@@ -92,6 +146,9 @@ func resourceIBMServiceKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error retrieving service key: %s", err)
 	}
 	d.Set("credentials", flattenServiceKeyCredentials(serviceKey.Entity.Credentials))
+	for k, v := range flattenServiceKeyCredentialFields(serviceKey.Entity.Credentials) {
+		d.Set(k, v)
+	}
 	d.Set("service_instance_guid", serviceKey.Entity.ServiceInstanceGUID)
 	d.Set("name", serviceKey.Entity.Name)
 
@@ -135,3 +192,46 @@ func resourceIBMServiceKeyExists(d *schema.ResourceData, meta interface{}) (bool
 
 	return serviceKey.Metadata.GUID == serviceKeyGUID, nil
 }
+
+// expandServiceKeyParameters returns the parameters of the service key from parameters or
+// parameters_json, with the role when it is set
+func expandServiceKeyParameters(d *schema.ResourceData) (map[string]interface{}, error) {
+	var parameters map[string]interface{}
+	if v, ok := d.GetOk("parameters"); ok {
+		parameters = v.(map[string]interface{})
+	}
+	if v, ok := d.GetOk("parameters_json"); ok {
+		// Already validated by the schema
+		if err := json.Unmarshal([]byte(v.(string)), &parameters); err != nil {
+			return nil, fmt.Errorf("Error parsing parameters_json: %s", err)
+		}
+	}
+	if role, ok := d.GetOk("role"); ok {
+		if parameters == nil {
+			parameters = map[string]interface{}{}
+		}
+		parameters["role"] = role.(string)
+	}
+	return parameters, nil
+}
+
+// flattenServiceKeyCredentialFields returns the well known fields of the credentials of the
+// IAM enabled, Cloud Object Storage and Cloudant services, empty when they are not in the credentials
+func flattenServiceKeyCredentialFields(creds map[string]interface{}) map[string]string {
+	str := func(m map[string]interface{}, k string) string {
+		if v, ok := m[k].(string); ok {
+			return v
+		}
+		return ""
+	}
+	hmacKeys, _ := creds["cos_hmac_keys"].(map[string]interface{})
+	return map[string]string{
+		"iam_role_crn":               str(creds, "iam_role_crn"),
+		"iam_apikey":                 str(creds, "apikey"),
+		"url":                        str(creds, "url"),
+		"username":                   str(creds, "username"),
+		"password":                   str(creds, "password"),
+		"cos_hmac_access_key_id":     str(hmacKeys, "access_key_id"),
+		"cos_hmac_secret_access_key": str(hmacKeys, "secret_access_key"),
+	}
+}
@@ -2,11 +2,15 @@ package ibm
 
 import (
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/IBM-Bluemix/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Bluemix/bluemix-go/bmxerror"
 	"github.com/IBM-Bluemix/bluemix-go/helpers"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func resourceIBMSpace() *schema.Resource {
@@ -76,12 +80,17 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		Name: name,
 	}
 
-	orgFields, err := cfClient.Organizations().FindByName(org, BluemixRegion)
+	orgFields, err := cfClient.Organizations().FindByName(org, meta.(ClientSession).BluemixRegion())
 	if err != nil {
 		return fmt.Errorf("Error retrieving org: %s", err)
 	}
 	req.OrgGUID = orgFields.GUID
 
+	err = checkSpaceRoleUsers(meta, orgFields.GUID, spaceRoleUsersToAdd(d))
+	if err != nil {
+		return err
+	}
+
 	if spaceQuota, ok := d.GetOk("space_quota"); ok {
 		quota, err := cfClient.SpaceQuotas().FindByName(spaceQuota.(string), orgFields.GUID)
 		if err != nil {
@@ -195,6 +204,17 @@ func resourceIBMSpaceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	api := cfClient.Spaces()
+	if users := spaceRoleUsersToAdd(d); len(users) > 0 {
+		space, err := api.Get(id)
+		if err != nil {
+			return fmt.Errorf("Error retrieving space: %s", err)
+		}
+		err = checkSpaceRoleUsers(meta, space.Entity.OrgGUID, users)
+		if err != nil {
+			return err
+		}
+	}
+
 	_, err = api.Update(id, req)
 	if err != nil {
 		return fmt.Errorf("Error updating space: %s", err)
@@ -342,3 +362,121 @@ func updateAuditors(api mccpv2.Spaces, spaceGUID string, d *schema.ResourceData)
 	}
 	return nil
 }
+
+// spaceRoleUsersToAdd returns the users which are given a new role in the space
+func spaceRoleUsersToAdd(d *schema.ResourceData) []string {
+	users := []string{}
+	for _, role := range []string{"auditors", "managers", "developers"} {
+		o, n := d.GetChange(role)
+		users = append(users, expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())...)
+	}
+	return users
+}
+
+// checkSpaceRoleUsers checks that the users are members of the org, so that the roles are not
+// partially given when some users cannot be associated with the space
+func checkSpaceRoleUsers(meta interface{}, orgGUID string, users []string) error {
+	if len(users) == 0 {
+		return nil
+	}
+	cfClient, err := meta.(ClientSession).MccpAPI()
+	if err != nil {
+		return err
+	}
+	orgUsers, err := listOrgUsers(cfClient, orgGUID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving users in the org: %s", err)
+	}
+	if missing := missingOrgUsers(users, orgUsers); len(missing) > 0 {
+		return fmt.Errorf("The users %s are not members of the org, add them to the org before giving them a role in the space",
+			strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// paginatedClient is implemented by the mccpv2 client, which has no API listing the users of an org
+type paginatedClient interface {
+	GetPaginated(path string, resource interface{}, cb func(interface{}) bool) (*http.Response, error)
+}
+
+// listOrgUsers returns the user names of the members of the org
+func listOrgUsers(cfClient mccpv2.MccpServiceAPI, orgGUID string) ([]string, error) {
+	client, ok := cfClient.(paginatedClient)
+	if !ok {
+		return nil, fmt.Errorf("The Cloud Foundry client cannot list the users of the org")
+	}
+	users := []string{}
+	_, err := client.GetPaginated(fmt.Sprintf("/v2/organizations/%s/users", orgGUID), mccpv2.SpaceRoleResource{}, func(resource interface{}) bool {
+		if user, ok := resource.(mccpv2.SpaceRoleResource); ok {
+			users = append(users, user.Entity.UserName)
+		}
+		return true
+	})
+	return users, err
+}
+
+// missingOrgUsers returns the sorted users which are not in the org users. The user names are
+// compared ignoring the case.
+func missingOrgUsers(users []string, orgUsers []string) []string {
+	names := make(map[string]bool, len(orgUsers))
+	for _, user := range orgUsers {
+		names[strings.ToLower(user)] = true
+	}
+	missing := []string{}
+	seen := map[string]bool{}
+	for _, user := range users {
+		if !names[strings.ToLower(user)] && !seen[user] {
+			missing = append(missing, user)
+			seen[user] = true
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// checkSpacePlan checks the users given a new role when the space is planned, so that the plan
+// lists the users which are not members of the org instead of the apply failing
+func checkSpacePlan(sess ClientSession, info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) error {
+	users := plannedSpaceRoleUsers(d)
+	if len(users) == 0 {
+		return nil
+	}
+	org := ""
+	if attr, ok := d.Attributes["org"]; ok && !attr.NewComputed {
+		org = attr.New
+	} else if s != nil {
+		org = s.Attributes["org"]
+	}
+	if org == "" {
+		return nil
+	}
+	cfClient, err := sess.MccpAPI()
+	if err != nil {
+		return err
+	}
+	orgFields, err := cfClient.Organizations().FindByName(org, sess.BluemixRegion())
+	if err != nil {
+		return fmt.Errorf("%s: Error retrieving org: %s", info.HumanId(), err)
+	}
+	if err := checkSpaceRoleUsers(sess, orgFields.GUID, users); err != nil {
+		return fmt.Errorf("%s: %s", info.HumanId(), err)
+	}
+	return nil
+}
+
+// plannedSpaceRoleUsers returns the users added to the roles of the space by the diff
+func plannedSpaceRoleUsers(d *terraform.InstanceDiff) []string {
+	users := []string{}
+	for key, attr := range d.Attributes {
+		parts := strings.SplitN(key, ".", 2)
+		if len(parts) != 2 || parts[1] == "#" || attr.NewRemoved || attr.NewComputed || attr.New == "" || attr.Old == attr.New {
+			continue
+		}
+		switch parts[0] {
+		case "auditors", "managers", "developers":
+			users = append(users, attr.New)
+		}
+	}
+	sort.Strings(users)
+	return users
+}
@@ -2,6 +2,7 @@ package ibm
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -108,6 +109,34 @@ func TestAccIBMSpace_with_roles(t *testing.T) {
 	})
 }
 
+func TestMissingOrgUsers(t *testing.T) {
+	orgUsers := []string{"dev@example.com", "Manager@example.com"}
+	users := []string{"manager@example.com", "zed@example.com", "dev@example.com", "alice@example.com", "zed@example.com"}
+	expected := []string{"alice@example.com", "zed@example.com"}
+	if missing := missingOrgUsers(users, orgUsers); !reflect.DeepEqual(missing, expected) {
+		t.Errorf("Expected %v, got %v", expected, missing)
+	}
+	if missing := missingOrgUsers([]string{"dev@example.com"}, orgUsers); len(missing) != 0 {
+		t.Errorf("Expected no missing users, got %v", missing)
+	}
+}
+
+func TestPlannedSpaceRoleUsers(t *testing.T) {
+	d := &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{
+		"name":            {Old: "dev", New: "test"},
+		"developers.#":    {Old: "1", New: "2"},
+		"developers.1234": {Old: "", New: "new@example.com"},
+		"developers.5678": {Old: "old@example.com", New: "", NewRemoved: true},
+		"managers.4321":   {Old: "", New: "boss@example.com"},
+		"auditors.9876":   {Old: "", NewComputed: true},
+		"tags.1111":       {Old: "", New: "dev"},
+	}}
+	expected := []string{"boss@example.com", "new@example.com"}
+	if users := plannedSpaceRoleUsers(d); !reflect.DeepEqual(users, expected) {
+		t.Errorf("Expected %v, got %v", expected, users)
+	}
+}
+
 func TestAccIBMSpace_With_Tags(t *testing.T) {
 	var conf mccpv2.SpaceFields
 	name := fmt.Sprintf("terraform_%d", acctest.RandInt())
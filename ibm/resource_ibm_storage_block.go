@@ -12,7 +12,6 @@ import (
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/helpers/network"
 	"github.com/softlayer/softlayer-go/services"
-	"github.com/softlayer/softlayer-go/sl"
 )
 
 func resourceIBMStorageBlock() *schema.Resource {
@@ -195,16 +194,16 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 
 	switch storageType {
 	case enduranceType:
-		receipt, err = services.GetProductOrderService(sess).PlaceOrder(
+		receipt, err = placeOrder(meta, storageOrderContainer.PackageId,
 			&datatypes.Container_Product_Order_Network_Storage_Enterprise{
 				Container_Product_Order: storageOrderContainer,
 				OsFormatType: &datatypes.Network_Storage_Iscsi_OS_Type{
 					Id:      osType.Id,
 					KeyName: osType.KeyName,
 				},
-			}, sl.Bool(false))
+			})
 	case performanceType:
-		receipt, err = services.GetProductOrderService(sess).PlaceOrder(
+		receipt, err = placeOrder(meta, storageOrderContainer.PackageId,
 			&datatypes.Container_Product_Order_Network_PerformanceStorage_Iscsi{
 				Container_Product_Order_Network_PerformanceStorage: datatypes.Container_Product_Order_Network_PerformanceStorage{
 					Container_Product_Order: storageOrderContainer,
@@ -213,7 +212,7 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 					Id:      osType.Id,
 					KeyName: osType.KeyName,
 				},
-			}, sl.Bool(false))
+			})
 	default:
 		return fmt.Errorf("Error during creation of storage: Invalid storageType %s", storageType)
 	}
@@ -222,37 +221,23 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error during creation of storage: %s", err)
 	}
 
-	// Find the storage device
-	blockStorage, err := findStorageByOrderId(sess, *receipt.OrderId)
-
-	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
-	}
-	d.SetId(fmt.Sprintf("%d", *blockStorage.Id))
-
-	// Wait for storage availability
-	_, err = WaitForStorageAvailable(d, meta)
-
-	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for storage (%s) to become ready: %s", d.Id(), err)
+	if !waitForOrderedStorage(d, meta, *receipt.OrderId) {
+		return nil
 	}
 
-	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
-	blockStorage, err = findStorageByOrderId(sess, *receipt.OrderId)
-
-	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
-	}
-	d.SetId(fmt.Sprintf("%d", *blockStorage.Id))
-
-	log.Printf("[INFO] Storage ID: %s", d.Id())
-
 	return resourceIBMStorageBlockUpdate(d, meta)
 }
 
 func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(ClientSession).SoftLayerSession()
+
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingStorage(d, sess)
+		if err != nil || !provisioned {
+			return err
+		}
+	}
+
 	storageId, _ := strconv.Atoi(d.Id())
 
 	storage, err := services.GetNetworkStorageService(sess).
@@ -338,6 +323,10 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) error {
+	if isPendingOrderID(d.Id()) {
+		return pendingOrderNotProvisionedError("storage", d.Id())
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -0,0 +1,359 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/filter"
+	"github.com/softlayer/softlayer-go/helpers/location"
+	"github.com/softlayer/softlayer-go/helpers/product"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const (
+	evaultPackageType  = "ADDITIONAL_SERVICES"
+	evaultCategoryCode = "evault"
+	evaultMask         = "id,username,password,capacityGb,serviceResourceName,serviceResourceBackendIpAddress," +
+		"guestId,hardwareId,serviceResource[datacenter[name]]"
+)
+
+func resourceIBMStorageEvault() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMStorageEvaultCreate,
+		Read:     resourceIBMStorageEvaultRead,
+		Delete:   resourceIBMStorageEvaultDelete,
+		Exists:   resourceIBMStorageEvaultExists,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"datacenter": {
+				Description: "The datacenter of the EVault backup storage",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"capacity": {
+				Description:  "The capacity of the EVault backup storage, in GB",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIntegerInRange(1, 12000),
+			},
+
+			"virtual_instance_id": {
+				Description:   "The ID of the virtual guest backed up to the EVault storage",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"hardware_instance_id"},
+			},
+
+			"hardware_instance_id": {
+				Description:   "The ID of the bare metal server backed up to the EVault storage",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"virtual_instance_id"},
+			},
+
+			"username": {
+				Description: "The user name of the EVault backup storage",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"password": {
+				Description: "The password of the EVault backup storage",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+
+			"service_resource_name": {
+				Description: "The name of the EVault service of the backup storage",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"service_resource_backend_ip_address": {
+				Description: "The private IP address of the EVault service of the backup storage",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIBMStorageEvaultCreate(d *schema.ResourceData, meta interface{}) error {
+	guestID := d.Get("virtual_instance_id").(int)
+	hardwareID := d.Get("hardware_instance_id").(int)
+	if guestID == 0 && hardwareID == 0 {
+		return fmt.Errorf("One of virtual_instance_id or hardware_instance_id must be set")
+	}
+
+	orderID, err := placeEvaultOrder(meta, d.Get("datacenter").(string), d.Get("capacity").(int), guestID, hardwareID)
+	if err != nil {
+		return err
+	}
+	setPendingOrderID(d, orderID)
+
+	evault, err := findEvaultByOrderId(meta.(ClientSession).SoftLayerSession(), orderID)
+	if err != nil {
+		logPendingOrderWait("EVault storage", orderID, err)
+		return nil
+	}
+
+	d.SetId(strconv.Itoa(*evault.Id))
+	log.Printf("[INFO] EVault storage ID: %s", d.Id())
+
+	return resourceIBMStorageEvaultRead(d, meta)
+}
+
+func resourceIBMStorageEvaultRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingEvault(d, sess)
+		if err != nil || !provisioned {
+			return err
+		}
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+	}
+
+	evault, err := services.GetNetworkStorageBackupEvaultService(sess).Id(id).Mask(evaultMask).GetObject()
+	if err != nil {
+		return fmt.Errorf("Error retrieving EVault storage %d: %s", id, err)
+	}
+
+	d.Set("datacenter", sl.Grab(evault, "ServiceResource.Datacenter.Name", ""))
+	d.Set("capacity", sl.Get(evault.CapacityGb, 0))
+	d.Set("virtual_instance_id", sl.Get(evault.GuestId, 0))
+	d.Set("hardware_instance_id", sl.Get(evault.HardwareId, 0))
+	d.Set("username", sl.Get(evault.Username, ""))
+	d.Set("password", sl.Get(evault.Password, ""))
+	d.Set("service_resource_name", sl.Get(evault.ServiceResourceName, ""))
+	d.Set("service_resource_backend_ip_address", sl.Get(evault.ServiceResourceBackendIpAddress, ""))
+
+	return nil
+}
+
+func resourceIBMStorageEvaultDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingEvault(d, sess)
+		if err != nil {
+			return err
+		}
+		if !provisioned {
+			return pendingOrderNotProvisionedError("EVault storage", d.Id())
+		}
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+	}
+
+	err = cancelEvault(sess, id)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMStorageEvaultExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	if isPendingOrderID(d.Id()) {
+		return true, nil
+	}
+
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+	}
+
+	_, err = services.GetNetworkStorageBackupEvaultService(sess).Id(id).Mask("id").GetObject()
+	if err != nil {
+		if isSoftLayerNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error retrieving EVault storage %d: %s", id, err)
+	}
+	return true, nil
+}
+
+// placeEvaultOrder places the order of an EVault backup storage of the capacity for the virtual
+// guest or the hardware, and returns the ID of the order
+func placeEvaultOrder(meta interface{}, datacenter string, capacity, guestID, hardwareID int) (int, error) {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	order, err := buildEvaultOrderContainer(sess, datacenter, capacity, guestID, hardwareID)
+	if err != nil {
+		return 0, fmt.Errorf("Error building the EVault storage order: %s", err)
+	}
+
+	log.Printf("[INFO] Ordering %d GB EVault storage in %s", capacity, datacenter)
+	receipt, err := placeOrder(meta, order.PackageId, &order)
+	if err != nil {
+		return 0, fmt.Errorf("Error ordering EVault storage: %s", err)
+	}
+	return *receipt.OrderId, nil
+}
+
+func buildEvaultOrderContainer(sess *session.Session, datacenter string, capacity, guestID, hardwareID int) (datatypes.Container_Product_Order_Network_Storage_Backup_Evault_Vault, error) {
+	pkg, err := product.GetPackageByType(sess, evaultPackageType)
+	if err != nil {
+		return datatypes.Container_Product_Order_Network_Storage_Backup_Evault_Vault{}, err
+	}
+
+	keyName := evaultKeyName(capacity)
+	items, err := services.GetProductPackageService(sess).Id(*pkg.Id).Mask(itemMask).
+		Filter(filter.Build(filter.Path("items.keyName").Eq(keyName))).GetItems()
+	if err != nil {
+		return datatypes.Container_Product_Order_Network_Storage_Backup_Evault_Vault{}, err
+	}
+	price, err := getPrice(items, keyName, evaultCategoryCode, "", 0)
+	if err != nil {
+		return datatypes.Container_Product_Order_Network_Storage_Backup_Evault_Vault{}, err
+	}
+
+	dc, err := location.GetDatacenterByName(sess, datacenter)
+	if err != nil {
+		return datatypes.Container_Product_Order_Network_Storage_Backup_Evault_Vault{},
+			fmt.Errorf("No data centers matching %s could be found", datacenter)
+	}
+
+	order := datatypes.Container_Product_Order_Network_Storage_Backup_Evault_Vault{
+		Container_Product_Order: datatypes.Container_Product_Order{
+			PackageId: pkg.Id,
+			Location:  sl.String(strconv.Itoa(*dc.Id)),
+			Prices:    []datatypes.Product_Item_Price{{Id: price.Id}},
+			Quantity:  sl.Int(1),
+		},
+	}
+	if guestID != 0 {
+		order.VirtualGuests = []datatypes.Virtual_Guest{{Id: sl.Int(guestID)}}
+	} else {
+		order.Hardware = []datatypes.Hardware{{Id: sl.Int(hardwareID)}}
+	}
+	return order, nil
+}
+
+// evaultKeyName returns the key name of the EVault item of the capacity, in GB
+func evaultKeyName(capacity int) string {
+	return fmt.Sprintf("EVAULT_%d_GB", capacity)
+}
+
+func findEvaultByOrderId(sess *session.Session, orderId int) (datatypes.Network_Storage, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"complete"},
+		Refresh: func() (interface{}, string, error) {
+			evault, found, err := getEvaultByOrderId(sess, orderId)
+			if err != nil {
+				return datatypes.Network_Storage{}, "", err
+			}
+			if !found {
+				return nil, "pending", nil
+			}
+			return evault, "complete", nil
+		},
+		Timeout:        45 * time.Minute,
+		Delay:          10 * time.Second,
+		MinTimeout:     10 * time.Second,
+		NotFoundChecks: 300,
+	}
+
+	pendingResult, err := stateConf.WaitForState()
+	if err != nil {
+		return datatypes.Network_Storage{}, err
+	}
+
+	if result, ok := pendingResult.(datatypes.Network_Storage); ok {
+		return result, nil
+	}
+	return datatypes.Network_Storage{}, fmt.Errorf("Cannot find EVault storage with order id '%d'", orderId)
+}
+
+// getEvaultByOrderId returns the EVault storage of the order, if it is already provisioned
+func getEvaultByOrderId(sess *session.Session, orderId int) (datatypes.Network_Storage, bool, error) {
+	evaults, err := services.GetAccountService(sess).
+		Filter(filter.Build(
+			filter.Path("evaultNetworkStorage.billingItem.orderItem.order.id").
+				Eq(strconv.Itoa(orderId)))).
+		Mask("id").
+		GetEvaultNetworkStorage()
+	if err != nil {
+		return datatypes.Network_Storage{}, false, err
+	}
+
+	switch len(evaults) {
+	case 0:
+		return datatypes.Network_Storage{}, false, nil
+	case 1:
+		return evaults[0], true, nil
+	}
+	return datatypes.Network_Storage{}, false, fmt.Errorf("Expected one EVault storage for order %d, found %d", orderId, len(evaults))
+}
+
+// adoptPendingEvault replaces the pending order id of the EVault storage by the id of the storage
+// once it is provisioned, and tells whether it is
+func adoptPendingEvault(d *schema.ResourceData, sess *session.Session) (bool, error) {
+	orderId, err := pendingOrderID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	evault, found, err := getEvaultByOrderId(sess, orderId)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving EVault storage of order %d: %s", orderId, err)
+	}
+	if !found {
+		log.Printf("[INFO] The EVault storage of order %d is not provisioned yet", orderId)
+		return false, nil
+	}
+	d.SetId(strconv.Itoa(*evault.Id))
+	log.Printf("[INFO] Adopted EVault storage %s of order %d", d.Id(), orderId)
+	return true, nil
+}
+
+// cancelEvault cancels the billing item of the EVault storage. A storage which was already
+// deleted is ignored.
+func cancelEvault(sess *session.Session, id int) error {
+	billingItem, err := services.GetNetworkStorageBackupEvaultService(sess).Id(id).GetBillingItem()
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The EVault storage %d was already deleted", id)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error while looking up billing item associated with the EVault storage: %s", err)
+	}
+	if billingItem.Id == nil {
+		return fmt.Errorf("Error while looking up billing item associated with the EVault storage: No billing item for ID:%d", id)
+	}
+
+	log.Printf("[INFO] Cancelling EVault storage %d", id)
+	success, err := services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
+	if err != nil {
+		return fmt.Errorf("Error cancelling EVault storage %d: %s", id, err)
+	}
+	if !success {
+		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation of EVault storage %d", id)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package ibm
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/softlayer/softlayer-go/services"
+)
+
+func TestAccIBMStorageEvault_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMStorageEvaultDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMStorageEvaultConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMStorageEvaultExists("ibm_storage_evault.evault"),
+					resource.TestCheckResourceAttr("ibm_storage_evault.evault", "capacity", "20"),
+					testAccCheckIBMResources("ibm_storage_evault.evault", "datacenter",
+						"ibm_compute_vm_instance.evaultvm1", "datacenter"),
+					testAccCheckIBMResources("ibm_storage_evault.evault", "virtual_instance_id",
+						"ibm_compute_vm_instance.evaultvm1", "id"),
+					resource.TestCheckResourceAttrSet("ibm_storage_evault.evault", "username"),
+					resource.TestCheckResourceAttrSet("ibm_storage_evault.evault", "password"),
+					resource.TestCheckResourceAttrSet("ibm_storage_evault.evault", "service_resource_name"),
+				),
+			},
+
+			resource.TestStep{
+				ResourceName:      "ibm_storage_evault.evault",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestEvaultKeyName(t *testing.T) {
+	cases := map[int]string{
+		20:   "EVAULT_20_GB",
+		250:  "EVAULT_250_GB",
+		1000: "EVAULT_1000_GB",
+	}
+	for capacity, expected := range cases {
+		if keyName := evaultKeyName(capacity); keyName != expected {
+			t.Errorf("evaultKeyName(%d) = %s, expected %s", capacity, keyName, expected)
+		}
+	}
+}
+
+func testAccCheckIBMStorageEvaultExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		evaultId, _ := strconv.Atoi(rs.Primary.ID)
+
+		service := services.GetNetworkStorageBackupEvaultService(testAccProvider.Meta().(ClientSession).SoftLayerSession())
+		foundEvault, err := service.Id(evaultId).GetObject()
+		if err != nil {
+			return err
+		}
+
+		if strconv.Itoa(*foundEvault.Id) != rs.Primary.ID {
+			return fmt.Errorf("Record not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIBMStorageEvaultDestroy(s *terraform.State) error {
+	service := services.GetNetworkStorageBackupEvaultService(testAccProvider.Meta().(ClientSession).SoftLayerSession())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_storage_evault" {
+			continue
+		}
+
+		evaultId, _ := strconv.Atoi(rs.Primary.ID)
+
+		// The billing item of a cancelled storage is removed
+		billingItem, err := service.Id(evaultId).GetBillingItem()
+		if err == nil && billingItem.Id != nil {
+			return fmt.Errorf("EVault storage %d still exists", evaultId)
+		}
+	}
+
+	return nil
+}
+
+const testAccCheckIBMStorageEvaultConfig_basic = `
+resource "ibm_compute_vm_instance" "evaultvm1" {
+    hostname = "evaultvm1"
+    domain = "terraformuat.ibm.com"
+    os_reference_code = "DEBIAN_7_64"
+    datacenter = "dal06"
+    network_speed = 100
+    hourly_billing = true
+    private_network_only = false
+    cores = 1
+    memory = 1024
+    disks = [25]
+    local_disk = false
+}
+
+resource "ibm_storage_evault" "evault" {
+    datacenter = "${ibm_compute_vm_instance.evaultvm1.datacenter}"
+    capacity = 20
+    virtual_instance_id = "${ibm_compute_vm_instance.evaultvm1.id}"
+}
+`
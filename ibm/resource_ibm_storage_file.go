@@ -244,17 +244,17 @@ func resourceIBMStorageFileCreate(d *schema.ResourceData, meta interface{}) erro
 
 	switch storageType {
 	case enduranceType:
-		receipt, err = services.GetProductOrderService(sess).PlaceOrder(
+		receipt, err = placeOrder(meta, storageOrderContainer.PackageId,
 			&datatypes.Container_Product_Order_Network_Storage_Enterprise{
 				Container_Product_Order: storageOrderContainer,
-			}, sl.Bool(false))
+			})
 	case performanceType:
-		receipt, err = services.GetProductOrderService(sess).PlaceOrder(
+		receipt, err = placeOrder(meta, storageOrderContainer.PackageId,
 			&datatypes.Container_Product_Order_Network_PerformanceStorage_Nfs{
 				Container_Product_Order_Network_PerformanceStorage: datatypes.Container_Product_Order_Network_PerformanceStorage{
 					Container_Product_Order: storageOrderContainer,
 				},
-			}, sl.Bool(false))
+			})
 	default:
 		return fmt.Errorf("Error during creation of storage: Invalid storageType %s", storageType)
 	}
@@ -263,37 +263,23 @@ func resourceIBMStorageFileCreate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error during creation of storage: %s", err)
 	}
 
-	// Find the storage device
-	fileStorage, err := findStorageByOrderId(sess, *receipt.OrderId)
-
-	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
-	}
-	d.SetId(fmt.Sprintf("%d", *fileStorage.Id))
-
-	// Wait for storage availability
-	_, err = WaitForStorageAvailable(d, meta)
-
-	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for storage (%s) to become ready: %s", d.Id(), err)
+	if !waitForOrderedStorage(d, meta, *receipt.OrderId) {
+		return nil
 	}
 
-	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
-	fileStorage, err = findStorageByOrderId(sess, *receipt.OrderId)
-
-	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
-	}
-	d.SetId(fmt.Sprintf("%d", *fileStorage.Id))
-
-	log.Printf("[INFO] Storage ID: %s", d.Id())
-
 	return resourceIBMStorageFileUpdate(d, meta)
 }
 
 func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error {
 	sess := meta.(ClientSession).SoftLayerSession()
+
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingStorage(d, sess)
+		if err != nil || !provisioned {
+			return err
+		}
+	}
+
 	storageId, _ := strconv.Atoi(d.Id())
 
 	storage, err := services.GetNetworkStorageService(sess).
@@ -375,6 +361,10 @@ func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) error {
+	if isPendingOrderID(d.Id()) {
+		return pendingOrderNotProvisionedError("storage", d.Id())
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -445,11 +435,24 @@ func resourceIBMStorageFileDelete(d *schema.ResourceData, meta interface{}) erro
 	sess := meta.(ClientSession).SoftLayerSession()
 	storageService := services.GetNetworkStorageService(sess)
 
+	if isPendingOrderID(d.Id()) {
+		provisioned, err := adoptPendingStorage(d, sess)
+		if err != nil {
+			return err
+		}
+		if !provisioned {
+			return pendingOrderNotProvisionedError("storage", d.Id())
+		}
+	}
+
 	storageID, _ := strconv.Atoi(d.Id())
 
 	// Get billing item associated with the storage
 	billingItem, err := storageService.Id(storageID).GetBillingItem()
-
+	if isSoftLayerNotFound(err) {
+		log.Printf("[WARN] The storage %d was already deleted", storageID)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("Error while looking up billing item associated with the storage: %s", err)
 	}
@@ -470,6 +473,10 @@ func resourceIBMStorageFileDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceIBMStorageFileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	if isPendingOrderID(d.Id()) {
+		return true, nil
+	}
+
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	storageID, err := strconv.Atoi(d.Id())
@@ -645,6 +652,61 @@ func findStorageByOrderId(sess *session.Session, orderId int) (datatypes.Network
 		fmt.Errorf("Cannot find Storage with order id '%d'", orderId)
 }
 
+// waitForOrderedStorage records the order of the storage in its id as soon as it is placed, then
+// waits for the storage to be provisioned and sets its id. It tells whether the storage was
+// provisioned, the storage which is not provisioned in time is adopted by the next refresh.
+func waitForOrderedStorage(d *schema.ResourceData, meta interface{}, orderID int) bool {
+	sess := meta.(ClientSession).SoftLayerSession()
+	setPendingOrderID(d, orderID)
+
+	storage, err := findStorageByOrderId(sess, orderID)
+	if err != nil {
+		logPendingOrderWait("storage", orderID, err)
+		return false
+	}
+	d.SetId(fmt.Sprintf("%d", *storage.Id))
+
+	_, err = WaitForStorageAvailable(d, meta)
+	if err == nil {
+		// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
+		storage, err = findStorageByOrderId(sess, orderID)
+	}
+	if err != nil {
+		setPendingOrderID(d, orderID)
+		logPendingOrderWait("storage", orderID, err)
+		return false
+	}
+	d.SetId(fmt.Sprintf("%d", *storage.Id))
+
+	log.Printf("[INFO] Storage ID: %s", d.Id())
+	return true
+}
+
+// adoptPendingStorage replaces the pending order id of the storage by the id of the storage once
+// it is provisioned, and tells whether it is
+func adoptPendingStorage(d *schema.ResourceData, sess *session.Session) (bool, error) {
+	orderId, err := pendingOrderID(d.Id())
+	if err != nil {
+		return false, err
+	}
+	storage, err := services.GetAccountService(sess).
+		Filter(filter.Build(
+			filter.Path("networkStorage.billingItem.orderItem.order.id").
+				Eq(strconv.Itoa(orderId)))).
+		Mask("id,activeTransactions").
+		GetNetworkStorage()
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving storage of order %d: %s", orderId, err)
+	}
+	if len(storage) != 1 || len(storage[0].ActiveTransactions) > 0 {
+		log.Printf("[INFO] The storage of order %d is not provisioned yet", orderId)
+		return false, nil
+	}
+	d.SetId(strconv.Itoa(*storage[0].Id))
+	log.Printf("[INFO] Adopted storage %s of order %d", d.Id(), orderId)
+	return true, nil
+}
+
 // Waits for storage provisioning
 func WaitForStorageAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for storage (%s) to be available.", d.Id())
@@ -0,0 +1,123 @@
+package ibm
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/IBM-Bluemix/bluemix-go/api/account/accountv1"
+	"github.com/IBM-Bluemix/bluemix-go/api/account/accountv2"
+	"github.com/IBM-Bluemix/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Bluemix/bluemix-go/api/iampap/iampapv1"
+	"github.com/IBM-Bluemix/bluemix-go/api/mccp/mccpv2"
+	"github.com/IBM-Bluemix/bluemix-go/endpoints"
+	bxsession "github.com/IBM-Bluemix/bluemix-go/session"
+)
+
+// The names of the Bluemix services, used to report the errors of their configuration
+const (
+	accountService   = "Account Service"
+	accountV1Service = "Bluemix Accountv1 Service"
+	containerService = "Container Service for K8s cluster"
+	iamPAPService    = "Bluemix IAMPAP Service"
+	mccpService      = "MCCP service"
+)
+
+// bluemixClientFactory creates the client of a Bluemix service
+type bluemixClientFactory func(sess *bxsession.Session) (interface{}, error)
+
+// bluemixClientFactories creates the clients of the Bluemix services by service name. A new
+// Bluemix service is added here, with an accessor on ClientSession.
+var bluemixClientFactories = map[string]bluemixClientFactory{
+	accountService: func(sess *bxsession.Session) (interface{}, error) {
+		return accountv2.New(sess)
+	},
+	accountV1Service: func(sess *bxsession.Session) (interface{}, error) {
+		return accountv1.New(sess)
+	},
+	containerService: func(sess *bxsession.Session) (interface{}, error) {
+		return containerv1.New(sess)
+	},
+	iamPAPService: func(sess *bxsession.Session) (interface{}, error) {
+		return iampapv1.New(sess)
+	},
+	mccpService: func(sess *bxsession.Session) (interface{}, error) {
+		return mccpv2.New(sess)
+	},
+}
+
+// lazyClient holds the client of a service, created once on first use
+type lazyClient struct {
+	once   sync.Once
+	client interface{}
+	err    error
+}
+
+// bluemixClients creates the clients of the Bluemix services when they are first used, so that the
+// services which are not used by the configuration are never configured. It is safe for concurrent
+// use by the resources.
+type bluemixClients struct {
+	session   *bxsession.Session
+	factories map[string]bluemixClientFactory
+
+	mu      sync.Mutex
+	clients map[string]*lazyClient
+}
+
+func newBluemixClients(sess *bxsession.Session, factories map[string]bluemixClientFactory) *bluemixClients {
+	return &bluemixClients{
+		session:   sess,
+		factories: factories,
+		clients:   map[string]*lazyClient{},
+	}
+}
+
+// client returns the client of the service in the region of the provider, creating it on the
+// first call
+func (c *bluemixClients) client(service string) (interface{}, error) {
+	return c.regionalClient(service, "")
+}
+
+// regionalClient returns the client of the service in the region, creating it on the first call.
+// The client of the region of the provider is returned when region is empty.
+func (c *bluemixClients) regionalClient(service, region string) (interface{}, error) {
+	if c == nil || c.session == nil {
+		//Can be nil only if bluemix_api_key is not provided
+		return nil, errEmptyBluemixCredentials
+	}
+	if c.session.Config != nil && region == c.session.Config.Region {
+		region = ""
+	}
+
+	key := service
+	if region != "" {
+		key = service + "@" + region
+	}
+
+	c.mu.Lock()
+	lc, ok := c.clients[key]
+	if !ok {
+		lc = &lazyClient{}
+		c.clients[key] = lc
+	}
+	c.mu.Unlock()
+
+	lc.once.Do(func() {
+		factory, ok := c.factories[service]
+		if !ok {
+			lc.err = fmt.Errorf("Unknown Bluemix service: %s", service)
+			return
+		}
+		sess := c.session
+		if region != "" {
+			// The endpoints of the services are located from the region of the session
+			sess = c.session.Copy()
+			sess.Config.Region = region
+			sess.Config.EndpointLocator = endpoints.NewEndpointLocator(region)
+		}
+		lc.client, lc.err = factory(sess)
+		if lc.err != nil {
+			lc.err = fmt.Errorf("Error occured while configuring %s: %q", service, lc.err)
+		}
+	})
+	return lc.client, lc.err
+}
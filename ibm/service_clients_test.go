@@ -0,0 +1,121 @@
+package ibm
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	bluemix "github.com/IBM-Bluemix/bluemix-go"
+	bxsession "github.com/IBM-Bluemix/bluemix-go/session"
+)
+
+func TestBluemixClientsCreatesClientOnce(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	factories := map[string]bluemixClientFactory{
+		"test": func(sess *bxsession.Session) (interface{}, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			return "client", nil
+		},
+	}
+	clients := newBluemixClients(&bxsession.Session{}, factories)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client, err := clients.client("test")
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			}
+			if client != "client" {
+				t.Errorf("Expected the client of the factory, got %v", client)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("Expected the client to be created once, got %d", calls)
+	}
+}
+
+func TestBluemixClientsDoesNotCreateUnusedClients(t *testing.T) {
+	factories := map[string]bluemixClientFactory{
+		"used": func(sess *bxsession.Session) (interface{}, error) {
+			return "client", nil
+		},
+		"unused": func(sess *bxsession.Session) (interface{}, error) {
+			t.Errorf("Expected the unused client not to be created")
+			return nil, nil
+		},
+	}
+	clients := newBluemixClients(&bxsession.Session{}, factories)
+
+	if _, err := clients.client("used"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestBluemixClientsErrors(t *testing.T) {
+	factories := map[string]bluemixClientFactory{
+		"failing": func(sess *bxsession.Session) (interface{}, error) {
+			return nil, errors.New("no endpoint")
+		},
+	}
+
+	_, err := newBluemixClients(nil, factories).client("failing")
+	if err != errEmptyBluemixCredentials {
+		t.Errorf("Expected the missing credentials error, got %v", err)
+	}
+
+	clients := newBluemixClients(&bxsession.Session{}, factories)
+	_, err = clients.client("failing")
+	if err == nil || !strings.Contains(err.Error(), "Error occured while configuring failing") {
+		t.Errorf("Expected the configuration error, got %v", err)
+	}
+
+	_, err = clients.client("missing")
+	if err == nil || !strings.Contains(err.Error(), "Unknown Bluemix service") {
+		t.Errorf("Expected the unknown service error, got %v", err)
+	}
+}
+
+func TestBluemixClientsRegionalClient(t *testing.T) {
+	regions := []string{}
+	factories := map[string]bluemixClientFactory{
+		"test": func(sess *bxsession.Session) (interface{}, error) {
+			regions = append(regions, sess.Config.Region)
+			return sess.Config.Region, nil
+		},
+	}
+	sess := &bxsession.Session{Config: &bluemix.Config{Region: "us-south"}}
+	clients := newBluemixClients(sess, factories)
+
+	for _, region := range []string{"", "us-south", "eu-de", "eu-de"} {
+		if _, err := clients.regionalClient("test", region); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	if !reflect.DeepEqual(regions, []string{"us-south", "eu-de"}) {
+		t.Errorf("Expected one client for the provider region and one for eu-de, got %v", regions)
+	}
+	if sess.Config.Region != "us-south" {
+		t.Errorf("Expected the session of the provider not to be modified, got %s", sess.Config.Region)
+	}
+}
+
+func TestValidateContainerRegion(t *testing.T) {
+	if _, errs := validateContainerRegion("eu-de", "region"); len(errs) != 0 {
+		t.Errorf("Expected eu-de to be valid, got %v", errs)
+	}
+	if _, errs := validateContainerRegion("moon-1", "region"); len(errs) != 1 {
+		t.Errorf("Expected moon-1 to be invalid")
+	}
+}
@@ -0,0 +1,174 @@
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// orderSerializer serializes the orders placed for the same SoftLayer product package,
+// as SoftLayer may reject concurrent orders for a package, and optionally limits the
+// number of orders placed concurrently across all packages.
+type orderSerializer struct {
+	semaphore chan struct{}
+
+	mu       sync.Mutex
+	packages map[int]*sync.Mutex
+}
+
+func newOrderSerializer(maxConcurrentOrders int) *orderSerializer {
+	o := &orderSerializer{
+		packages: map[int]*sync.Mutex{},
+	}
+	if maxConcurrentOrders > 0 {
+		o.semaphore = make(chan struct{}, maxConcurrentOrders)
+	}
+	return o
+}
+
+// acquire blocks until an order can be placed for the package and returns the
+// function releasing it
+func (o *orderSerializer) acquire(packageID int) func() {
+	o.mu.Lock()
+	lock, ok := o.packages[packageID]
+	if !ok {
+		lock = &sync.Mutex{}
+		o.packages[packageID] = lock
+	}
+	o.mu.Unlock()
+
+	// The package lock is taken first, so that an order waiting for its package doesn't hold one
+	// of the max_concurrent_orders slots
+	lock.Lock()
+	if o.semaphore != nil {
+		o.semaphore <- struct{}{}
+	}
+
+	return func() {
+		if o.semaphore != nil {
+			<-o.semaphore
+		}
+		lock.Unlock()
+	}
+}
+
+// placeOrder places the order once no other order of the same package is in flight. When
+// dry_run_quote is set on the provider, the order is only verified and never placed, the resources
+// which support quotes don't call placeOrder then.
+func placeOrder(meta interface{}, packageID *int, orderData interface{}) (datatypes.Container_Product_Order_Receipt, error) {
+	sess := meta.(ClientSession)
+
+	if sess.DryRunQuote() {
+		return datatypes.Container_Product_Order_Receipt{}, dryRunQuoteError(sess, orderData)
+	}
+
+	release := sess.OrderSerializer().acquire(sl.Get(packageID, 0).(int))
+	defer release()
+
+	return services.GetProductOrderService(sess.SoftLayerSession()).PlaceOrder(orderData, sl.Bool(false))
+}
+
+// dryRunQuoteError verifies the order of a resource which can't record a quote, and returns the
+// error failing its creation with the price of the order
+func dryRunQuoteError(sess ClientSession, orderData interface{}) error {
+	order, err := services.GetProductOrderService(sess.SoftLayerSession()).VerifyOrder(orderData)
+	if err != nil {
+		return fmt.Errorf("Error verifying order: %s", err)
+	}
+	return fmt.Errorf("dry_run_quote is set on the provider, the order was verified but not placed: "+
+		"hourly cost %.2f, monthly cost %.2f, setup cost %.2f. The resource can't record a quote, "+
+		"remove it from the configuration or unset dry_run_quote to order it",
+		float64(sl.Get(order.PostTaxRecurringHourly, datatypes.Float64(0)).(datatypes.Float64)),
+		float64(sl.Get(order.PostTaxRecurringMonthly, sl.Get(order.PostTaxRecurring, datatypes.Float64(0))).(datatypes.Float64)),
+		float64(sl.Get(order.PostTaxSetup, datatypes.Float64(0)).(datatypes.Float64)))
+}
+
+// quoteIDPrefix prefixes the id of the resources whose order was only priced, see dry_run_quote
+const quoteIDPrefix = "quote:"
+
+// isDryRunQuote tells whether the order of the resource must only be priced, either because
+// dry_run_quote is set on the resource or on the provider
+func isDryRunQuote(d *schema.ResourceData, meta interface{}) bool {
+	return d.Get("dry_run_quote").(bool) || meta.(ClientSession).DryRunQuote()
+}
+
+func isQuoteID(id string) bool {
+	return strings.HasPrefix(id, quoteIDPrefix)
+}
+
+// quoteOrder verifies the order instead of placing it, and records the priced quote on the resource
+func quoteOrder(d *schema.ResourceData, meta interface{}, orderData interface{}) error {
+	sess := meta.(ClientSession).SoftLayerSession()
+
+	order, err := services.GetProductOrderService(sess).VerifyOrder(orderData)
+	if err != nil {
+		return fmt.Errorf("Error verifying order: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s%d", quoteIDPrefix, time.Now().UnixNano()))
+	d.Set("quote_hourly_cost", float64(sl.Get(order.PostTaxRecurringHourly, datatypes.Float64(0)).(datatypes.Float64)))
+	d.Set("quote_monthly_cost", float64(sl.Get(order.PostTaxRecurringMonthly, sl.Get(order.PostTaxRecurring, datatypes.Float64(0))).(datatypes.Float64)))
+	d.Set("quote_setup_cost", float64(sl.Get(order.PostTaxSetup, datatypes.Float64(0)).(datatypes.Float64)))
+
+	return nil
+}
+
+// orderWaitTimeout returns how long to wait for an ordered resource to be provisioned, from its
+// wait_time_minutes argument
+func orderWaitTimeout(d *schema.ResourceData) time.Duration {
+	return time.Duration(d.Get("wait_time_minutes").(int)) * time.Minute
+}
+
+// orderWaitInterval returns the minimum interval between two checks of the provisioning of an ordered
+// resource, from its wait_interval_seconds argument
+func orderWaitInterval(d *schema.ResourceData) time.Duration {
+	return time.Duration(d.Get("wait_interval_seconds").(int)) * time.Second
+}
+
+// pendingOrderIDPrefix prefixes the id of the resources whose order was placed but which are not
+// provisioned yet, so that a later refresh adopts them once provisioned instead of ordering them
+// again when the creation fails or is interrupted
+const pendingOrderIDPrefix = "order:"
+
+func isPendingOrderID(id string) bool {
+	return strings.HasPrefix(id, pendingOrderIDPrefix)
+}
+
+// pendingOrderID returns the SoftLayer order id recorded in the id of the resource
+func pendingOrderID(id string) (int, error) {
+	orderID, err := strconv.Atoi(strings.TrimPrefix(id, pendingOrderIDPrefix))
+	if err != nil {
+		return 0, fmt.Errorf("Not a valid pending order ID %q, must be %s followed by an integer", id, pendingOrderIDPrefix)
+	}
+	return orderID, nil
+}
+
+// setPendingOrderID records the order of the resource in its id as soon as the order is placed. The
+// id is replaced by the id of the resource once it is provisioned.
+func setPendingOrderID(d *schema.ResourceData, orderID int) {
+	d.SetId(fmt.Sprintf("%s%d", pendingOrderIDPrefix, orderID))
+}
+
+// logPendingOrderWait logs that a resource whose order is recorded in its id was not provisioned in
+// time. The creation succeeds rather than failing, as Terraform would taint the resource and the
+// next apply would destroy it and order it again.
+func logPendingOrderWait(resourceName string, orderID int, err error) {
+	log.Printf("[WARN] Error waiting for the %s of order %d to be provisioned: %s. "+
+		"The order was placed and is recorded in the state, the %s is adopted by the next refresh or apply once provisioned",
+		resourceName, orderID, err, resourceName)
+}
+
+// pendingOrderNotProvisionedError returns the error of a change to a resource whose order is not
+// provisioned yet
+func pendingOrderNotProvisionedError(resourceName string, id string) error {
+	return fmt.Errorf("The %s of order %s is not provisioned yet, apply again once it is",
+		resourceName, strings.TrimPrefix(id, pendingOrderIDPrefix))
+}
@@ -0,0 +1,157 @@
+package ibm
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	slsession "github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestOrderSerializer_samePackage(t *testing.T) {
+	o := newOrderSerializer(0)
+	var inFlight, maxInFlight int32
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release := o.acquire(42)
+			defer release()
+			n := atomic.AddInt32(&inFlight, 1)
+			mu.Lock()
+			if n > maxInFlight {
+				maxInFlight = n
+			}
+			mu.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+		}()
+	}
+	wg.Wait()
+	if maxInFlight != 1 {
+		t.Fatalf("expected orders of the same package to be serialized, got %d in flight", maxInFlight)
+	}
+}
+
+func TestOrderSerializer_maxConcurrentOrders(t *testing.T) {
+	o := newOrderSerializer(2)
+	var inFlight, maxInFlight int32
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func(packageID int) {
+			defer wg.Done()
+			release := o.acquire(packageID)
+			defer release()
+			n := atomic.AddInt32(&inFlight, 1)
+			mu.Lock()
+			if n > maxInFlight {
+				maxInFlight = n
+			}
+			mu.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+		}(i)
+	}
+	wg.Wait()
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 orders in flight, got %d", maxInFlight)
+	}
+}
+
+func TestOrderSerializer_waitingOrderHoldsNoSlot(t *testing.T) {
+	o := newOrderSerializer(2)
+	release := o.acquire(1)
+
+	// A second order of package 1 waits for the first one
+	waiting := make(chan struct{})
+	go func() {
+		close(waiting)
+		o.acquire(1)()
+	}()
+	<-waiting
+	time.Sleep(5 * time.Millisecond)
+
+	// An order of another package gets the second slot meanwhile
+	acquired := make(chan struct{})
+	go func() {
+		o.acquire(2)()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("expected an order of another package not to wait for a slot held by a waiting order")
+	}
+	release()
+}
+
+func TestPendingOrder(t *testing.T) {
+	d := resourceIBMNetworkVlan().TestResourceData()
+	setPendingOrderID(d, 1234)
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	logPendingOrderWait("vlan", 1234, errors.New("timeout while waiting for state to become 'complete'"))
+	log.SetOutput(os.Stderr)
+	if !strings.Contains(buf.String(), "[WARN]") || !strings.Contains(buf.String(), "order 1234") {
+		t.Errorf("Expected a warning naming order 1234, got %q", buf.String())
+	}
+	if !isPendingOrderID(d.Id()) || isQuoteID(d.Id()) {
+		t.Fatalf("Expected a pending order ID, got %s", d.Id())
+	}
+	orderID, err := pendingOrderID(d.Id())
+	if err != nil || orderID != 1234 {
+		t.Errorf("Expected order 1234, got %d, %v", orderID, err)
+	}
+	if _, err := pendingOrderID("order:abc"); err == nil {
+		t.Errorf("Expected an error for an invalid pending order ID")
+	}
+	if isPendingOrderID("1234") {
+		t.Errorf("Expected 1234 not to be a pending order ID")
+	}
+
+	err = pendingOrderNotProvisionedError("vlan", d.Id())
+	if !strings.Contains(err.Error(), "vlan of order 1234") {
+		t.Errorf("Expected the error to name order 1234, got %s", err)
+	}
+}
+
+func TestPlaceOrder_dryRunQuote(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"postTaxRecurringHourly":0.5,"postTaxRecurringMonthly":300,"postTaxSetup":0}`))
+	}))
+	defer server.Close()
+
+	sess := clientSession{
+		session: &Session{SoftLayerSession: &slsession.Session{
+			Endpoint:         server.URL,
+			TransportHandler: newSoftLayerRestTransport(newRequestTagger("")),
+		}},
+		orderSerializer: newOrderSerializer(0),
+		dryRunQuote:     true,
+	}
+
+	_, err := placeOrder(sess, sl.Int(1), &datatypes.Container_Product_Order{PackageId: sl.Int(1)})
+	if err == nil || !strings.Contains(err.Error(), "not placed") || !strings.Contains(err.Error(), "monthly cost 300.00") {
+		t.Fatalf("Expected the order not to be placed, got %v", err)
+	}
+	if len(paths) != 1 || paths[0] != "/SoftLayer_Product_Order/verifyOrder.json" {
+		t.Errorf("Expected only verifyOrder to be called, got %v", paths)
+	}
+}
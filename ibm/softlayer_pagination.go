@@ -0,0 +1,132 @@
+package ibm
+
+import (
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+)
+
+// accountResultLimit is the number of objects requested per call when listing
+// the objects of an account. SoftLayer truncates large result sets, so list
+// calls on the account service must be paginated with a result limit/offset.
+const accountResultLimit = 100
+
+// paginate calls fetch with increasing offsets until a page smaller than limit
+// is returned. fetch returns the number of objects of the requested page.
+func paginate(limit int, fetch func(offset, limit int) (int, error)) error {
+	for offset := 0; ; offset += limit {
+		count, err := fetch(offset, limit)
+		if err != nil {
+			return err
+		}
+		if count < limit {
+			return nil
+		}
+	}
+}
+
+// getAccountNetworkVlans lists all the vlans of the account matching the mask and
+// filter already set on service
+func getAccountNetworkVlans(service services.Account) ([]datatypes.Network_Vlan, error) {
+	result := []datatypes.Network_Vlan{}
+	err := paginate(accountResultLimit, func(offset, limit int) (int, error) {
+		vlans, err := service.Offset(offset).Limit(limit).GetNetworkVlans()
+		result = append(result, vlans...)
+		return len(vlans), err
+	})
+	return result, err
+}
+
+// getAccountVirtualGuests lists all the virtual guests of the account matching the
+// mask and filter already set on service
+func getAccountVirtualGuests(service services.Account) ([]datatypes.Virtual_Guest, error) {
+	result := []datatypes.Virtual_Guest{}
+	err := paginate(accountResultLimit, func(offset, limit int) (int, error) {
+		guests, err := service.Offset(offset).Limit(limit).GetVirtualGuests()
+		result = append(result, guests...)
+		return len(guests), err
+	})
+	return result, err
+}
+
+// getAccountHardware lists all the hardware of the account matching the mask and
+// filter already set on service
+func getAccountHardware(service services.Account) ([]datatypes.Hardware, error) {
+	result := []datatypes.Hardware{}
+	err := paginate(accountResultLimit, func(offset, limit int) (int, error) {
+		hardware, err := service.Offset(offset).Limit(limit).GetHardware()
+		result = append(result, hardware...)
+		return len(hardware), err
+	})
+	return result, err
+}
+
+// getAccountSshKeys lists all the ssh keys of the account matching the mask and
+// filter already set on service
+func getAccountSshKeys(service services.Account) ([]datatypes.Security_Ssh_Key, error) {
+	result := []datatypes.Security_Ssh_Key{}
+	err := paginate(accountResultLimit, func(offset, limit int) (int, error) {
+		keys, err := service.Offset(offset).Limit(limit).GetSshKeys()
+		result = append(result, keys...)
+		return len(keys), err
+	})
+	return result, err
+}
+
+// getAccountDomains lists all the dns domains of the account matching the mask and
+// filter already set on service
+func getAccountDomains(service services.Account) ([]datatypes.Dns_Domain, error) {
+	result := []datatypes.Dns_Domain{}
+	err := paginate(accountResultLimit, func(offset, limit int) (int, error) {
+		domains, err := service.Offset(offset).Limit(limit).GetDomains()
+		result = append(result, domains...)
+		return len(domains), err
+	})
+	return result, err
+}
+
+// getAccountBlockDeviceTemplateGroups lists all the image templates of the account
+// matching the mask and filter already set on service
+func getAccountBlockDeviceTemplateGroups(service services.Account) ([]datatypes.Virtual_Guest_Block_Device_Template_Group, error) {
+	result := []datatypes.Virtual_Guest_Block_Device_Template_Group{}
+	err := paginate(accountResultLimit, func(offset, limit int) (int, error) {
+		templates, err := service.Offset(offset).Limit(limit).GetBlockDeviceTemplateGroups()
+		result = append(result, templates...)
+		return len(templates), err
+	})
+	return result, err
+}
+
+// getAccountSubnets lists all the subnets of the account matching the mask and
+// filter already set on service
+func getAccountSubnets(service services.Account) ([]datatypes.Network_Subnet, error) {
+	result := []datatypes.Network_Subnet{}
+	err := paginate(accountResultLimit, func(offset, limit int) (int, error) {
+		subnets, err := service.Offset(offset).Limit(limit).GetSubnets()
+		result = append(result, subnets...)
+		return len(subnets), err
+	})
+	return result, err
+}
+
+// getAccountNextInvoiceTopLevelBillingItems lists all the top level billing items of the next
+// invoice of the account matching the mask and filter already set on service
+func getAccountNextInvoiceTopLevelBillingItems(service services.Account) ([]datatypes.Billing_Item, error) {
+	result := []datatypes.Billing_Item{}
+	err := paginate(accountResultLimit, func(offset, limit int) (int, error) {
+		items, err := service.Offset(offset).Limit(limit).GetNextInvoiceTopLevelBillingItems()
+		result = append(result, items...)
+		return len(items), err
+	})
+	return result, err
+}
+
+// getScaleGroupNetworkVlans lists all the network vlans of the scale group set on service
+func getScaleGroupNetworkVlans(service services.Scale_Group) ([]datatypes.Scale_Network_Vlan, error) {
+	result := []datatypes.Scale_Network_Vlan{}
+	err := paginate(accountResultLimit, func(offset, limit int) (int, error) {
+		vlans, err := service.Offset(offset).Limit(limit).GetNetworkVlans()
+		result = append(result, vlans...)
+		return len(vlans), err
+	})
+	return result, err
+}
@@ -0,0 +1,52 @@
+package ibm
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	total := 250
+	var offsets []int
+	err := paginate(100, func(offset, limit int) (int, error) {
+		offsets = append(offsets, offset)
+		if total-offset < limit {
+			return total - offset, nil
+		}
+		return limit, nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(offsets) != 3 || offsets[0] != 0 || offsets[1] != 100 || offsets[2] != 200 {
+		t.Fatalf("unexpected offsets requested: %v", offsets)
+	}
+}
+
+func TestPaginate_exactPage(t *testing.T) {
+	calls := 0
+	err := paginate(100, func(offset, limit int) (int, error) {
+		calls++
+		if offset == 0 {
+			return limit, nil
+		}
+		return 0, nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestPaginate_error(t *testing.T) {
+	calls := 0
+	err := paginate(100, func(offset, limit int) (int, error) {
+		calls++
+		return 0, errors.New("boom")
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("expected the error of the first call to be returned, got %v after %d calls", err, calls)
+	}
+}
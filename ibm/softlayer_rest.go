@@ -0,0 +1,186 @@
+package ibm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	slsession "github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+// softLayerRestTransport mirrors session.RestTransport of softlayer-go, from session/rest.go at the
+// vendored revision 413a1c1e3dca8e0b979bc834181df95ae70bfd50 (2017-06-21). It can't be wrapped: the
+// vendored transport always sends the requests with http.DefaultClient, and sets its timeout. Only
+// makeHTTPRequest differs, it uses the HTTP client of the provider session so that the requests of
+// each session are tagged with its own User-Agent. Sync it with session/rest.go when softlayer-go
+// is updated.
+type softLayerRestTransport struct {
+	client *http.Client
+}
+
+func newSoftLayerRestTransport(tagger *requestTagger) *softLayerRestTransport {
+	return &softLayerRestTransport{
+		client: &http.Client{Transport: newUserAgentTransport(http.DefaultTransport, tagger)},
+	}
+}
+
+func (t *softLayerRestTransport) DoRequest(sess *slsession.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	var parameters []byte
+	if len(args) > 0 {
+		parameters, _ = json.Marshal(map[string]interface{}{"parameters": args})
+	}
+
+	resp, code, err := t.makeHTTPRequest(sess, restPath(service, method, options), restMethod(method, args),
+		bytes.NewBuffer(parameters), options)
+	if err != nil {
+		return sl.Error{Wrapped: err}
+	}
+
+	if code < 200 || code > 299 {
+		e := sl.Error{StatusCode: code}
+		if err := json.Unmarshal(resp, &e); err != nil {
+			e.Wrapped = err
+			e.Message = err.Error()
+		}
+		return e
+	}
+
+	// Some APIs that normally return a collection omit the []'s when they return a single value
+	returnType := reflect.TypeOf(pResult).String()
+	if strings.Index(returnType, "[]") == 1 && strings.Index(string(resp), "[") != 0 {
+		resp = []byte("[" + string(resp) + "]")
+	}
+
+	switch result := pResult.(type) {
+	case *[]uint8:
+		// exclude quotes
+		*result = resp[1 : len(resp)-1]
+	case *datatypes.Void:
+	case *uint:
+		var val uint64
+		val, err = strconv.ParseUint(string(resp), 0, 64)
+		if err == nil {
+			*result = uint(val)
+		}
+	case *bool:
+		*result, err = strconv.ParseBool(string(resp))
+	case *string:
+		str := string(resp)
+		if str == "null" {
+			str = ""
+		} else if strings.HasPrefix(str, `"`) && strings.HasSuffix(str, `"`) {
+			var unquoted string
+			if err = json.Unmarshal(resp, &unquoted); err == nil {
+				str = unquoted
+			}
+		}
+		*result = str
+	default:
+		err = json.Unmarshal(resp, pResult)
+	}
+
+	if err != nil {
+		return sl.Error{Message: err.Error(), Wrapped: err}
+	}
+	return nil
+}
+
+func (t *softLayerRestTransport) makeHTTPRequest(sess *slsession.Session, path, requestType string, body *bytes.Buffer, options *sl.Options) ([]byte, int, error) {
+	endpoint := sess.Endpoint
+	if endpoint == "" {
+		endpoint = slsession.DefaultEndpoint
+	}
+	req, err := http.NewRequest(requestType, fmt.Sprintf("%s/%s", strings.TrimRight(endpoint, "/"), path), body)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if sess.APIKey != "" {
+		req.SetBasicAuth(sess.UserName, sess.APIKey)
+	} else if sess.AuthToken != "" {
+		req.SetBasicAuth(fmt.Sprintf("%d", sess.UserId), sess.AuthToken)
+	}
+	req.URL.RawQuery = restQuery(options)
+
+	if sess.Debug {
+		log.Println("[DEBUG] Request URL: ", requestType, req.URL)
+		log.Println("[DEBUG] Parameters: ", body.String())
+	}
+
+	// The timeout is set on a copy, the client is shared by the requests of the session
+	client := *t.client
+	client.Timeout = slsession.DefaultTimeout
+	if sess.Timeout != 0 {
+		client.Timeout = sess.Timeout
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 520, err
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+
+	if sess.Debug {
+		log.Println("[DEBUG] Response: ", string(responseBody))
+	}
+	return responseBody, resp.StatusCode, nil
+}
+
+func restPath(service, method string, options *sl.Options) string {
+	path := service
+	if options.Id != nil {
+		path = path + "/" + strconv.Itoa(*options.Id)
+	}
+
+	// The API method name is omitted for the basic REST methods
+	switch method {
+	case "getObject", "deleteObject", "createObject", "createObjects", "editObject", "editObjects":
+	default:
+		path = path + "/" + method
+	}
+	return path + ".json"
+}
+
+func restQuery(options *sl.Options) string {
+	query := url.Values{}
+	if options.Mask != "" {
+		query.Add("objectMask", options.Mask)
+	}
+	if options.Filter != "" {
+		query.Add("objectFilter", options.Filter)
+	}
+	if options.Limit != nil {
+		offset := 0
+		if options.Offset != nil {
+			offset = *options.Offset
+		}
+		query.Add("resultLimit", fmt.Sprintf("%d,%d", offset, *options.Limit))
+	}
+	return query.Encode()
+}
+
+func restMethod(method string, args []interface{}) string {
+	switch {
+	case method == "deleteObject":
+		return "DELETE"
+	case method == "editObject" || method == "editObjects":
+		return "PUT"
+	case method == "createObject" || method == "createObjects" || len(args) > 0:
+		return "POST"
+	}
+	return "GET"
+}
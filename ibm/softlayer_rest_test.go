@@ -0,0 +1,86 @@
+package ibm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/softlayer/softlayer-go/datatypes"
+	slsession "github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestSoftLayerRestTransport(t *testing.T) {
+	var userAgent, path, query, method string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get(userAgentHeader)
+		path = r.URL.Path
+		query = r.URL.Query().Get("objectMask")
+		method = r.Method
+		w.Write([]byte(`{"id":42,"hostname":"web"}`))
+	}))
+	defer server.Close()
+
+	defaultTransport := http.DefaultClient.Transport
+	correlationIDs := []string{"pipeline-1", "pipeline-2"}
+	sessions := make([]*slsession.Session, 0, len(correlationIDs))
+	for _, correlationID := range correlationIDs {
+		sessions = append(sessions, &slsession.Session{
+			Endpoint:         server.URL,
+			TransportHandler: newSoftLayerRestTransport(newRequestTagger(correlationID)),
+		})
+	}
+	if http.DefaultClient.Transport != defaultTransport {
+		t.Fatalf("Expected the default HTTP client not to be modified")
+	}
+
+	for i, sess := range sessions {
+		var guest datatypes.Virtual_Guest
+		err := sess.DoRequest("SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(42), Mask: "id,hostname"}, &guest)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if *guest.Id != 42 || *guest.Hostname != "web" {
+			t.Errorf("Expected the guest to be parsed, got %#v", guest)
+		}
+		if path != "/SoftLayer_Virtual_Guest/42.json" || query != "id,hostname" || method != "GET" {
+			t.Errorf("Unexpected request %s %s?objectMask=%s", method, path, query)
+		}
+		if expected := "correlation-id=" + correlationIDs[i]; !strings.Contains(userAgent, expected) {
+			t.Errorf("Expected the user agent to contain %q, got %q", expected, userAgent)
+		}
+	}
+}
+
+func TestSoftLayerRestTransport_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+		w.Write([]byte(`{"error":"Unable to find object with id of '1'.","code":"SoftLayer_Exception_ObjectNotFound"}`))
+	}))
+	defer server.Close()
+
+	sess := &slsession.Session{Endpoint: server.URL, TransportHandler: newSoftLayerRestTransport(newRequestTagger(""))}
+	var guest datatypes.Virtual_Guest
+	err := sess.DoRequest("SoftLayer_Virtual_Guest", "getObject", nil, &sl.Options{Id: sl.Int(1)}, &guest)
+	if !isSoftLayerNotFound(err) {
+		t.Fatalf("Expected a not found error, got %#v", err)
+	}
+}
+
+func TestRestMethod(t *testing.T) {
+	cases := map[string]string{
+		"getObject":    "GET",
+		"deleteObject": "DELETE",
+		"editObject":   "PUT",
+		"createObject": "POST",
+	}
+	for method, expected := range cases {
+		if actual := restMethod(method, nil); actual != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, method, actual)
+		}
+	}
+	if actual := restMethod("getItems", []interface{}{1}); actual != "POST" {
+		t.Errorf("Expected POST for a method with parameters, got %s", actual)
+	}
+}
@@ -112,6 +112,18 @@ func flattenSSHKeyIDs(in []datatypes.Security_Ssh_Key) *schema.Set {
 	return schema.NewSet(HashInt, out)
 }
 
+// flattenTagReferences returns the names of the tags, empty when there is none so that the tags
+// removed outside of Terraform are cleared from the state
+func flattenTagReferences(in []datatypes.Tag_Reference) []string {
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		if v.Tag != nil && v.Tag.Name != nil {
+			out = append(out, *v.Tag.Name)
+		}
+	}
+	return out
+}
+
 func flattenSpaceRoleUsers(in []mccpv2.SpaceRole) *schema.Set {
 	var out = []interface{}{}
 	for _, v := range in {
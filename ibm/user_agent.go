@@ -0,0 +1,102 @@
+package ibm
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// The phase of the run is read-only until the first resource is created, updated or deleted,
+	// and mutating after. The provider can't tell a plan from an apply: an apply refreshes and
+	// diffs the resources in the read-only phase too.
+	phaseReadOnly = "read-only"
+	phaseMutating = "mutating"
+
+	userAgentHeader = "User-Agent"
+)
+
+// requestTagger identifies the Terraform run in the User-Agent of the SoftLayer and Bluemix API
+// calls, so that the API traffic can be attributed to a pipeline, and tags the phase of the run.
+type requestTagger struct {
+	correlationID string
+	phase         atomic.Value
+}
+
+func newRequestTagger(correlationID string) *requestTagger {
+	t := &requestTagger{correlationID: correlationID}
+	t.phase.Store(phaseReadOnly)
+	return t
+}
+
+func (t *requestTagger) setPhase(phase string) {
+	t.phase.Store(phase)
+}
+
+func (t *requestTagger) userAgent() string {
+	tags := "phase=" + t.phase.Load().(string)
+	if t.correlationID != "" {
+		tags = tags + "; correlation-id=" + t.correlationID
+	}
+	return fmt.Sprintf("terraform-provider-ibm (%s)", tags)
+}
+
+// AppendUserAgent appends the tags of the Terraform run to the User-Agent set by the API client
+func (t *requestTagger) AppendUserAgent(userAgent string) string {
+	if userAgent == "" {
+		return t.userAgent()
+	}
+	return userAgent + " " + t.userAgent()
+}
+
+// userAgentTransport is a http.RoundTripper which appends the tags of the Terraform run to the
+// User-Agent of the requests
+type userAgentTransport struct {
+	transport http.RoundTripper
+	tagger    *requestTagger
+}
+
+func newUserAgentTransport(transport http.RoundTripper, tagger *requestTagger) *userAgentTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	// Do not tag the requests twice when the transport is configured again
+	if t, ok := transport.(*userAgentTransport); ok {
+		transport = t.transport
+	}
+	return &userAgentTransport{transport: transport, tagger: tagger}
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request, so tag a copy
+	tagged := *req
+	tagged.Header = make(http.Header, len(req.Header))
+	for k, v := range req.Header {
+		tagged.Header[k] = v
+	}
+	tagged.Header.Set(userAgentHeader, t.tagger.AppendUserAgent(req.Header.Get(userAgentHeader)))
+
+	return t.transport.RoundTrip(&tagged)
+}
+
+// tagMutatingPhase switches the phase of the request tagger to mutating when the resource is
+// created, updated or deleted
+func tagMutatingPhase(r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			if sess, ok := meta.(ClientSession); ok {
+				sess.RequestTagger().setPhase(phaseMutating)
+			}
+			return f(d, meta)
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+}
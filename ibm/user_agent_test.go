@@ -0,0 +1,76 @@
+package ibm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestRequestTaggerAppendUserAgent(t *testing.T) {
+	tagger := newRequestTagger("")
+	expected := "terraform-provider-ibm (phase=read-only)"
+	if ua := tagger.AppendUserAgent(""); ua != expected {
+		t.Errorf("Expected %q, got %q", expected, ua)
+	}
+
+	tagger = newRequestTagger("pipeline-42")
+	tagger.setPhase(phaseMutating)
+	expected = "Go-http-client/1.1 terraform-provider-ibm (phase=mutating; correlation-id=pipeline-42)"
+	if ua := tagger.AppendUserAgent("Go-http-client/1.1"); ua != expected {
+		t.Errorf("Expected %q, got %q", expected, ua)
+	}
+}
+
+func TestUserAgentTransport(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get(userAgentHeader)
+	}))
+	defer server.Close()
+
+	tagger := newRequestTagger("pipeline-42")
+	// Configuring the transport twice must not tag the requests twice
+	transport := newUserAgentTransport(newUserAgentTransport(nil, tagger), tagger)
+	client := &http.Client{Transport: transport}
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Set(userAgentHeader, "Bluemix-go SDK")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if !strings.HasPrefix(userAgent, "Bluemix-go SDK terraform-provider-ibm") {
+		t.Errorf("Expected the user agent of the client to be tagged, got %q", userAgent)
+	}
+	if strings.Count(userAgent, "correlation-id=pipeline-42") != 1 {
+		t.Errorf("Expected the correlation ID once, got %q", userAgent)
+	}
+	if req.Header.Get(userAgentHeader) != "Bluemix-go SDK" {
+		t.Errorf("Expected the original request not to be modified, got %q", req.Header.Get(userAgentHeader))
+	}
+}
+
+func TestTagMutatingPhase(t *testing.T) {
+	tagger := newRequestTagger("")
+	meta := clientSession{requestTagger: tagger}
+
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error { return nil },
+	}
+	tagMutatingPhase(r)
+
+	if r.Update != nil || r.Delete != nil {
+		t.Errorf("Expected the missing functions not to be wrapped")
+	}
+	if err := r.Create(nil, meta); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if ua := tagger.userAgent(); !strings.Contains(ua, "(phase=mutating)") {
+		t.Errorf("Expected the mutating phase, got %q", ua)
+	}
+}
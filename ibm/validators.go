@@ -1,9 +1,13 @@
 package ibm
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/IBM-Bluemix/bluemix-go/endpoints"
 	"github.com/IBM-Bluemix/bluemix-go/helpers"
 	"github.com/hashicorp/terraform/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
@@ -127,3 +131,72 @@ func validateNotes(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+func validateCloudantDatabaseName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-z][a-z0-9_$()+/-]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) must start with a lowercase letter and contain only lowercase letters, digits and any of the characters _, $, (, ), +, - and /", k, value))
+	}
+	return
+}
+
+func validateLowercase(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != strings.ToLower(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) must be lowercase, the API doesn't keep its case", k, value))
+	}
+	return
+}
+
+func validateRFC3339Timestamp(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) must be a timestamp in RFC 3339 format, such as 2017-07-01T02:00:00Z", k, value))
+	}
+	return
+}
+
+func validateIntegerInRange(start, end int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(int)
+		if value < start || value > end {
+			errors = append(errors, fmt.Errorf(
+				"%q (%d) must be in the range of %d to %d", k, value, start, end))
+		}
+		return
+	}
+}
+
+func validateURLPath(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.HasPrefix(value, "/") {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) must start with a /", k, value))
+	}
+	return
+}
+
+// validateContainerRegion checks that the container service is available in the region, so that an
+// unknown region fails at plan time instead of when the API is called
+func validateContainerRegion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := endpoints.NewEndpointLocator(value).ContainerEndpoint(); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) is not a region of the container service", k, value))
+	}
+	return
+}
+
+// validateJSONObject checks that the value is a JSON object
+func validateJSONObject(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &object); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a JSON object: %s", k, err))
+	}
+	return
+}